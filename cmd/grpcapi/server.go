@@ -2,16 +2,39 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"expvar"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/aayushxrj/aws-url-shortner/internals/api/handlers"
+	"github.com/aayushxrj/aws-url-shortner/internals/api/interceptors"
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/clicks"
+	"github.com/aayushxrj/aws-url-shortner/internals/idgen"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/quota"
+	"github.com/aayushxrj/aws-url-shortner/internals/ratelimit"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/cache"
 	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+	"github.com/aayushxrj/aws-url-shortner/internals/screening"
+	"github.com/aayushxrj/aws-url-shortner/internals/tlsconfig"
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
 	pb "github.com/aayushxrj/aws-url-shortner/proto/gen"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -22,19 +45,178 @@ func main() {
 	// 	log.Fatalf("Error loading .env file: %v", err)
 	// }
 
-	//TODO
-	// Implement TLS
+	// SIGINT (Ctrl+C) and SIGTERM (docker stop, Kubernetes) start a graceful
+	// shutdown; background watchers stop with ctx.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// TLS for the gRPC and HTTP listeners. Certificate files are reloaded
+	// when they change; without any, both listeners serve plaintext.
+	devCert, err := newDevCert(utils.GetEnvBool("TLS_SELF_SIGNED", false))
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	grpcTLS, err := newTLSConfig(ctx, "GRPC_", os.Getenv("GRPC_TLS_CLIENT_CA_FILE"), devCert)
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	httpTLS, err := newTLSConfig(ctx, "HTTP_", "", devCert)
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
 
 	// Connect Database
-	client, err := db.NewDynamoClient()
+	store, err := newStore(os.Getenv("STORAGE_BACKEND"))
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	// Links stored before expiry was enforced are rewritten once, on request,
+	// so that those created without an expiry do not read as expired.
+	if legacy, ok := store.(repository.LegacyExpiryStore); ok && utils.GetEnvBool("MIGRATE_LEGACY_EXPIRY", false) {
+		changed, err := legacy.ClearLegacyExpiry(context.Background())
+		if err != nil {
+			log.Fatal("Error: failed to migrate legacy expiry: ", err)
+		}
+		fmt.Printf("✅ Cleared the expiry of %d links created without one\n", changed)
+	}
+
+	// Put the redirect lookup cache in front of the store. Writes made through
+	// the gRPC handlers invalidate cached entries, but only in the cache this
+	// instance uses: run several instances with the redis cache, or the lru
+	// cache on one instance keeps serving changed links for up to CACHE_TTL.
+	urlCache, err := newCache(utils.GetEnv("CACHE_BACKEND", "lru"))
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	client := cache.NewCachedStore(store, urlCache,
+		utils.GetEnvDuration("CACHE_TTL", 5*time.Minute),
+		utils.GetEnvDuration("CACHE_NEGATIVE_TTL", 30*time.Second))
+
+	// Short id generation strategy. Sequence based strategies draw from a
+	// counter kept by the storage backend.
+	alphabet, err := idgen.Alphabet(os.Getenv("ID_ALPHABET"))
+	if err != nil {
+		log.Fatal("Error: ID_ALPHABET: ", err)
+	}
+	seq, _ := store.(idgen.Sequencer)
+	idGen, err := idgen.New(os.Getenv("ID_STRATEGY"), alphabet, os.Getenv("ID_SALT"), seq)
 	if err != nil {
 		log.Fatal("Error:", err)
 	}
-	fmt.Println("✅ Connected to DynamoDB successfully!", client.DB)
+
+	// Destination screening. Further reputation providers can be appended to
+	// the chain.
+	checker := &screening.Chain{FailClosed: utils.GetEnvBool("SCREENING_FAIL_CLOSED", false)}
+	if path := os.Getenv("BLOCKLIST_FILE"); path != "" {
+		blocklist, err := screening.NewBlocklist(path)
+		if err != nil {
+			log.Fatal("Error:", err)
+		}
+		go blocklist.Watch(ctx, utils.GetEnvDuration("BLOCKLIST_RELOAD_INTERVAL", 30*time.Second))
+		checker.Checkers = append(checker.Checkers, blocklist)
+		fmt.Println("✅ Loaded destination blocklist", path)
+	}
+	screenOnRedirect := utils.GetEnvBool("SCREEN_ON_REDIRECT", false)
+
+	// Public location of short links, shared by the short_url returned from
+	// ShortenURL and the redirect server's routes.
+	defaultBaseURL := "http://localhost:8080"
+	if httpTLS != nil {
+		defaultBaseURL = "https://localhost:8080"
+	}
+	links, err := handlers.NewPublicLinks(
+		utils.GetEnv("PUBLIC_BASE_URL", defaultBaseURL),
+		utils.GetEnv("SHORT_URL_PREFIX", "/"))
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	// further hosts serving the primary domain, such as internal addresses
+	links.Hosts = shortenerHosts(links)
+
+	// API keys, workspaces and custom domains live in the same backing store
+	// as the links.
+	keys, _ := store.(repository.APIKeyStore)
+	workspaces, _ := store.(repository.WorkspaceStore)
+	domains, _ := store.(repository.DomainStore)
+	// requests for a verified custom domain resolve links on that domain
+	links.Domains = domains
+
+	// Per owner link quotas, counted in the same store. Zero limits are
+	// unlimited but usage is still reported by GetQuota.
+	counts, _ := store.(repository.QuotaStore)
+	limits, err := newQuotaLimits()
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	quotas := &quota.Enforcer{URLs: store, Counts: counts, Limits: limits}
+
+	// Rate limits per client IP and per caller, shared through Redis when
+	// several instances run behind a load balancer.
+	limiter, err := newLimiter(utils.GetEnv("RATE_LIMIT_BACKEND", "memory"))
+	if err != nil {
+		log.Fatal("Error:", err)
+	}
+	// Forwarded client addresses are only trusted when a listener can be
+	// reached solely through a proxy that sets them; otherwise every client
+	// could pick its own bucket. Without trust, clients behind a proxy all
+	// share the proxy's bucket, which is why gRPC has no per IP limit by
+	// default: the shipped setup serves it through Envoy.
+	trustProxy := utils.GetEnvBool("TRUST_PROXY_HEADERS", false)
+	httpTrustProxy := utils.GetEnvBool("HTTP_TRUST_PROXY_HEADERS", trustProxy)
+	rateLimiter := &interceptors.RateLimiter{
+		Limiter:    limiter,
+		TrustProxy: utils.GetEnvBool("GRPC_TRUST_PROXY_HEADERS", trustProxy),
+	}
+	if rateLimiter.ByIP, err = ratelimit.ParsePolicy(utils.GetEnv("RATE_LIMITS_IP", "redirect=100/s:200")); err != nil {
+		log.Fatal("Error: RATE_LIMITS_IP: ", err)
+	}
+	if rateLimiter.ByPrincipal, err = ratelimit.ParsePolicy(utils.GetEnv("RATE_LIMITS", "ShortenURL=10/s:20")); err != nil {
+		log.Fatal("Error: RATE_LIMITS: ", err)
+	}
+
+	unary := []grpc.UnaryServerInterceptor{rateLimiter.UnaryByPrincipal, interceptors.ValidationInterceptor}
+	if utils.GetEnvBool("AUTH_ENABLED", true) {
+		authenticator := &interceptors.Authenticator{
+			Keys:         keys,
+			BootstrapKey: os.Getenv("AUTH_BOOTSTRAP_KEY"),
+		}
+		if source := os.Getenv("JWT_JWKS"); source != "" {
+			authenticator.JWT, err = newJWTVerifier(source)
+			if err != nil {
+				log.Fatal("Error:", err)
+			}
+			fmt.Println("✅ Loaded JWT signing keys from", source)
+		}
+		// authenticate before validating so anonymous callers learn nothing
+		// about the request schema
+		unary = append([]grpc.UnaryServerInterceptor{authenticator.Unary}, unary...)
+	} else {
+		fmt.Println("⚠️ AUTH_ENABLED=false, the gRPC API is open to anyone who can reach it")
+	}
+	unary = append([]grpc.UnaryServerInterceptor{rateLimiter.UnaryByIP}, unary...)
 
 	// Start gRPC server
-	grpcServer := grpc.NewServer()
-	pb.RegisterUrlShortenerServer(grpcServer, &handlers.Server{DB: client})
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(unary...)}
+	if grpcTLS != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(grpcTLS)))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterUrlShortenerServer(grpcServer, &handlers.Server{
+		Store:      client,
+		Keys:       keys,
+		Workspaces: workspaces,
+		Domains:    domains,
+		Links:      links,
+		IDGen:      idGen,
+		IDLength:   utils.GetEnvInt("ID_LENGTH", 6),
+		URLValidator: &validation.URLValidator{
+			AllowedSchemes: utils.GetEnvList("ALLOWED_URL_SCHEMES", []string{"http", "https"}),
+			MaxLength:      utils.GetEnvInt("MAX_URL_LENGTH", validation.DefaultMaxURLLength),
+			SelfHosts:      links.Hosts,
+		},
+		Checker: checker,
+		Quota:   quotas,
+	})
 	reflection.Register(grpcServer)
 
 	grpcPort := os.Getenv("SERVER_PORT")
@@ -47,10 +229,12 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
+	// Both servers report here if they stop serving on their own.
+	serveErr := make(chan error, 3)
 	go func() {
 		fmt.Printf("gRPC server is running on port %s\n", grpcPort)
 		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve gRPC: %v", err)
+			serveErr <- fmt.Errorf("failed to serve gRPC: %w", err)
 		}
 	}()
 
@@ -60,36 +244,375 @@ func main() {
 		httpPort = "8080"
 	}
 
-	// Function to query DynamoDB for a short URL. It also increments the click
-	// counter asynchronously when a URL is found so redirects remain fast.
-	getLongURL := func(shortKey string) (string, bool) {
-		ctx := context.Background()
-		longURL, err := client.GetLongURL(ctx, shortKey)
-		if err != nil || longURL == "" {
-			return "", false
+	// Redirect clicks are counted in memory and written in batches, one write
+	// per link per flush, so a traffic spike does not turn into a write per
+	// request.
+	clickRetries := utils.GetEnvInt("CLICK_WRITE_RETRIES", 3)
+	clickCounter := clicks.New(client, clicks.Config{
+		FlushInterval: utils.GetEnvDuration("CLICK_FLUSH_INTERVAL", time.Second),
+		MaxPending:    utils.GetEnvInt("CLICK_BUFFER_SIZE", 10000),
+		Concurrency:   utils.GetEnvInt("CLICK_FLUSH_CONCURRENCY", 8),
+		Retries:       &clickRetries,
+		WriteTimeout:  utils.GetEnvDuration("CLICK_WRITE_TIMEOUT", 5*time.Second),
+	})
+	expvar.Publish("clicks", expvar.Func(func() any { return clickCounter.Stats() }))
+
+	// Function to query the store for a short URL. It also counts a click when
+	// a URL is found, without waiting for the store, so redirects remain fast.
+	getLongURL := func(ctx context.Context, shortKey string) (*models.URL, error) {
+		url, err := client.Resolve(ctx, shortKey)
+		if err != nil {
+			return nil, err
+		}
+		if url.OriginalURL == "" {
+			return nil, repository.ErrNotFound
 		}
 
-		// increment click count in background; log error if it fails
-		go func(k string) {
-			if err := client.IncrementClick(ctx, k); err != nil {
-				log.Printf("failed to increment click for %s: %v", k, err)
+		// Optionally re-screen on every redirect so links created before a
+		// rule was added are caught too.
+		if screenOnRedirect {
+			verdict, err := checker.Check(ctx, url.OriginalURL)
+			if err != nil {
+				return nil, err
+			}
+			if verdict.Blocked {
+				return nil, screening.ErrBlocked
 			}
-		}(shortKey)
+			url.Flagged = url.Flagged || verdict.Flagged
+		}
+
+		// a full buffer drops the click rather than slowing the redirect; the
+		// dropped counter in the clicks metrics shows how many were lost
+		clickCounter.Add(shortKey)
 
-		return longURL, true
+		return url, nil
 	}
 
 	// Wrap redirect handler with CORS middleware so browser preflight (OPTIONS)
 	// requests receive Access-Control-Allow-* headers. This helps when the
 	// frontend mistakenly calls the backend HTTP port directly (8080) instead
 	// of going through Envoy gRPC-Web proxy.
-	redirectHandler := handlers.RedirectHandler(getLongURL)
-	http.HandleFunc("/", corsMiddleware(redirectHandler))
+	redirectHandler := handlers.RedirectHandler(links, getLongURL)
+	if limit, ok := rateLimiter.ByIP.For(ratelimit.Redirect); ok {
+		redirectHandler = handlers.RateLimitMiddleware(limiter, limit, httpTrustProxy, redirectHandler)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(links.Prefix, corsMiddleware(redirectHandler))
+
+	fmt.Printf("HTTP redirect server is running on port %s, serving %s%s<id>\n", httpPort, links.BaseURL, links.Prefix)
+	httpServer := &http.Server{Addr: ":" + httpPort, Handler: mux, TLSConfig: httpTLS}
+	go func() {
+		var err error
+		if httpTLS != nil {
+			// the certificate comes from TLSConfig
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("failed to serve HTTP: %w", err)
+		}
+	}()
+
+	// Metrics are served on their own port so they are not public along
+	// with the redirects.
+	var metricsServer *http.Server
+	if metricsPort := os.Getenv("METRICS_PORT"); metricsPort != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/debug/vars", expvar.Handler())
+		metricsServer = &http.Server{Addr: ":" + metricsPort, Handler: metricsMux}
+		go func() {
+			fmt.Printf("Metrics server is running on port %s, serving /debug/vars\n", metricsPort)
+			if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				serveErr <- fmt.Errorf("failed to serve metrics: %w", err)
+			}
+		}()
+	}
+
+	exitCode := 0
+	select {
+	case err := <-serveErr:
+		log.Println("Error:", err)
+		exitCode = 1
+	case <-ctx.Done():
+		// restore the default handlers so a second signal exits at once
+		stop()
+		fmt.Println("Shutting down, draining in-flight requests")
+	}
+
+	drainTimeout := utils.GetEnvDuration("SHUTDOWN_TIMEOUT", 20*time.Second)
+	flushTimeout := utils.GetEnvDuration("CLICK_FLUSH_TIMEOUT", 5*time.Second)
+	if err := shutdown(drainTimeout, flushTimeout, httpServer, metricsServer, grpcServer, clickCounter, store, urlCache, limiter); err != nil {
+		log.Println("Error: shutdown:", err)
+		exitCode = 1
+	}
+	if exitCode == 0 {
+		fmt.Println("✅ Shutdown complete")
+	}
+	os.Exit(exitCode)
+}
+
+// shutdown drains both servers in parallel, giving up on requests still
+// running after drainTimeout; gRPC calls are cancelled then. It then writes
+// the clicks the servers counted within a deadline of its own,
+// flushTimeout, so that a slow drain cannot eat into it, and closes the
+// backends that hold connections, such as the store and Redis clients. The
+// metrics server, if any, stops last so the drain can be watched.
+func shutdown(drainTimeout, flushTimeout time.Duration, httpServer, metricsServer *http.Server, grpcServer *grpc.Server, clickCounter *clicks.Aggregator, backends ...any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	var errs []error
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("http server: %w", err))
+	}
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+		errs = append(errs, errors.New("grpc server: timed out, in-flight calls cancelled"))
+	}
+
+	// no redirect can count a click once the HTTP server is down
+	ctx, cancel = context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	if err := clickCounter.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("clicks: %w", err))
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("metrics server: %w", err))
+		}
+	}
+
+	for _, backend := range backends {
+		if closer, ok := backend.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("closing %T: %w", backend, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
 
-	fmt.Printf("HTTP redirect server is running on port %s\n", httpPort)
-	if err := http.ListenAndServe(":"+httpPort, nil); err != nil {
-		log.Fatalf("Failed to serve HTTP: %v", err)
+// newDevCert generates the self-signed development certificate when
+// TLS_SELF_SIGNED is enabled.
+func newDevCert(enabled bool) (*tlsconfig.Certificates, error) {
+	if !enabled {
+		return nil, nil
+	}
+	hosts := utils.GetEnvList("TLS_SELF_SIGNED_HOSTS", []string{"localhost", "127.0.0.1", "::1"})
+	cert, err := tlsconfig.SelfSigned(hosts, 365*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("⚠️ Using a self-signed TLS certificate, SHA-256 fingerprint", cert.Fingerprint())
+	return cert, nil
+}
+
+// newTLSConfig builds the TLS configuration of one listener from the
+// <prefix>TLS_CERT_FILE and <prefix>TLS_KEY_FILE variables, falling back to
+// TLS_CERT_FILE and TLS_KEY_FILE and then to devCert. With clientCAFile,
+// clients must present a certificate issued by one of its CAs. It returns
+// nil when the listener serves plaintext. Certificate files are watched
+// until ctx is done.
+func newTLSConfig(ctx context.Context, prefix, clientCAFile string, devCert *tlsconfig.Certificates) (*tls.Config, error) {
+	files := tlsconfig.Files{
+		CertFile:     utils.GetEnv(prefix+"TLS_CERT_FILE", os.Getenv("TLS_CERT_FILE")),
+		KeyFile:      utils.GetEnv(prefix+"TLS_KEY_FILE", os.Getenv("TLS_KEY_FILE")),
+		ClientCAFile: clientCAFile,
+	}
+	if files.CertFile == "" && files.KeyFile == "" {
+		if clientCAFile != "" {
+			return nil, fmt.Errorf("%sTLS_CLIENT_CA_FILE requires a certificate and key file", prefix)
+		}
+		if devCert == nil {
+			return nil, nil
+		}
+		return devCert.ServerConfig(), nil
+	}
+
+	certs, err := tlsconfig.Load(files)
+	if err != nil {
+		return nil, fmt.Errorf("%sTLS: %w", prefix, err)
+	}
+	go certs.Watch(ctx, utils.GetEnvDuration("TLS_RELOAD_INTERVAL", 30*time.Second))
+	if clientCAFile != "" {
+		fmt.Println("✅ Loaded TLS certificate", files.CertFile, "requiring client certificates from", clientCAFile)
+	} else {
+		fmt.Println("✅ Loaded TLS certificate", files.CertFile)
+	}
+	return certs.ServerConfig(), nil
+}
+
+// newStore builds the URL store selected by STORAGE_BACKEND. DynamoDB is used
+// when no backend is set.
+func newStore(backend string) (repository.URLStore, error) {
+	switch backend {
+	case "", "dynamodb":
+		client, err := db.NewDynamoClient()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Println("✅ Connected to DynamoDB successfully!", client.DB)
+		return client, nil
+	case "bolt":
+		path := os.Getenv("BOLT_PATH")
+		if path == "" {
+			path = "urls.db"
+		}
+		store, err := db.NewBoltStore(path)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Println("✅ Opened bolt database", path)
+		return store, nil
+	case "postgres":
+		dsn := os.Getenv("POSTGRES_DSN")
+		if dsn == "" {
+			return nil, fmt.Errorf("POSTGRES_DSN is required for the postgres backend")
+		}
+		store, err := db.NewPostgresStore(context.Background(), dsn)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Println("✅ Connected to PostgreSQL successfully!")
+		return store, nil
+	case "memory":
+		fmt.Println("⚠️ Using in-memory storage, links will not survive a restart")
+		return db.NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND %q", backend)
+	}
+}
+
+// shortenerHosts returns the hosts the primary domain is served from:
+// SHORTENER_HOSTS, by default the local addresses, and always the host of
+// PUBLIC_BASE_URL, so that links back to it are rejected however the list
+// is configured.
+func shortenerHosts(links *handlers.PublicLinks) []string {
+	hosts := utils.GetEnvList("SHORTENER_HOSTS", []string{"localhost:8080", "127.0.0.1:8080"})
+	if !slices.Contains(hosts, links.Host()) {
+		hosts = append(hosts, links.Host())
+	}
+	return hosts
+}
+
+// newJWTVerifier builds the bearer token verifier from the JWT_* variables.
+// source is a JWKS URL or a local JWKS file.
+func newJWTVerifier(source string) (*auth.JWTVerifier, error) {
+	audience := os.Getenv("JWT_AUDIENCE")
+	if audience == "" {
+		return nil, fmt.Errorf("JWT_AUDIENCE is required when JWT_JWKS is set")
+	}
+	issuer := os.Getenv("JWT_ISSUER")
+	if issuer == "" {
+		return nil, fmt.Errorf("JWT_ISSUER is required when JWT_JWKS is set")
+	}
+
+	var scopes []auth.Scope
+	for _, name := range utils.GetEnvList("JWT_DEFAULT_SCOPES", []string{"write"}) {
+		scope, err := auth.ParseScope(name)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_DEFAULT_SCOPES: %w", err)
+		}
+		scopes = append(scopes, scope)
+	}
+	roleScopes, err := auth.ParseRoleScopes(os.Getenv("JWT_ROLE_SCOPES"))
+	if err != nil {
+		return nil, fmt.Errorf("JWT_ROLE_SCOPES: %w", err)
+	}
+
+	keys, err := auth.NewJWKS(context.Background(), source, utils.GetEnvDuration("JWT_JWKS_REFRESH_INTERVAL", 15*time.Minute))
+	if err != nil {
+		return nil, err
+	}
+	return &auth.JWTVerifier{
+		Keys:          keys,
+		Issuer:        issuer,
+		Audience:      audience,
+		RolesClaim:    os.Getenv("JWT_ROLES_CLAIM"),
+		DefaultScopes: scopes,
+		RoleScopes:    roleScopes,
+		Leeway:        utils.GetEnvDuration("JWT_LEEWAY", time.Minute),
+	}, nil
+}
+
+// newCache builds the redirect lookup cache selected by CACHE_BACKEND.
+func newCache(backend string) (cache.Cache, error) {
+	switch backend {
+	case "lru":
+		return cache.NewLRU(utils.GetEnvInt("CACHE_SIZE", 10000)), nil
+	case "redis":
+		redisURL := os.Getenv("REDIS_URL")
+		if redisURL == "" {
+			return nil, fmt.Errorf("REDIS_URL is required for the redis cache")
+		}
+		c, err := cache.NewRedis(context.Background(), redisURL, "url:")
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to redis: %w", err)
+		}
+		fmt.Println("✅ Connected to Redis cache successfully!")
+		return c, nil
+	case "none":
+		return cache.Nop{}, nil
+	default:
+		return nil, fmt.Errorf("unknown CACHE_BACKEND %q", backend)
+	}
+}
+
+// newLimiter builds the rate limiter selected by RATE_LIMIT_BACKEND.
+func newLimiter(backend string) (ratelimit.Limiter, error) {
+	switch backend {
+	case "memory":
+		return ratelimit.NewMemory(), nil
+	case "redis":
+		redisURL := os.Getenv("REDIS_URL")
+		if redisURL == "" {
+			return nil, fmt.Errorf("REDIS_URL is required for the redis rate limiter")
+		}
+		l, err := ratelimit.NewRedis(context.Background(), redisURL, "ratelimit:")
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to redis: %w", err)
+		}
+		fmt.Println("✅ Connected to Redis rate limiter successfully!")
+		return l, nil
+	case "none":
+		return ratelimit.Unlimited{}, nil
+	default:
+		return nil, fmt.Errorf("unknown RATE_LIMIT_BACKEND %q", backend)
+	}
+}
+
+// newQuotaLimits reads QUOTA_MAX_ACTIVE_LINKS and QUOTA_MAX_DAILY_LINKS.
+// Unlike most settings a malformed value is an error rather than falling
+// back to the default, since the default, zero, lifts the cap.
+func newQuotaLimits() (quota.Limits, error) {
+	var limits quota.Limits
+	for _, setting := range []struct {
+		key   string
+		limit *int64
+	}{
+		{"QUOTA_MAX_ACTIVE_LINKS", &limits.MaxActiveLinks},
+		{"QUOTA_MAX_DAILY_LINKS", &limits.MaxDailyLinks},
+	} {
+		v := os.Getenv(setting.key)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			return quota.Limits{}, fmt.Errorf("invalid %s=%q, want a non-negative integer", setting.key, v)
+		}
+		*setting.limit = n
 	}
+	return limits, nil
 }
 
 // corsMiddleware sets CORS headers and handles OPTIONS preflight requests.
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/api/handlers"
+	"github.com/aayushxrj/aws-url-shortner/internals/clicks"
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
+	"google.golang.org/grpc"
+)
+
+func TestShortenerHostsKeepThePublicHost(t *testing.T) {
+	t.Setenv("SHORTENER_HOSTS", "short.example")
+	links, err := handlers.NewPublicLinks("https://sho.rt", "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	links.Hosts = shortenerHosts(links)
+	v := &validation.URLValidator{SelfHosts: links.Hosts}
+
+	for _, dest := range []string{"https://sho.rt/abc", "https://SHO.RT:443/abc", "https://short.example/abc"} {
+		if _, err := v.Normalize("original_url", dest); err == nil {
+			t.Errorf("Normalize(%q) accepted a link back to the shortener", dest)
+		}
+	}
+	if _, err := v.Normalize("original_url", "https://example.com/"); err != nil {
+		t.Errorf("Normalize(other host) = %v", err)
+	}
+	if !links.IsPrimary("sho.rt") {
+		t.Error("the public host is not served as the primary domain")
+	}
+}
+
+// countingStore records the clicks written by an aggregator.
+type countingStore struct {
+	mu     sync.Mutex
+	clicks map[string]int64
+}
+
+func (s *countingStore) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clicks[shortID] += n
+	return s.clicks[shortID], nil
+}
+
+func TestShutdownFlushesClicksAfterASlowDrain(t *testing.T) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+
+	store := &countingStore{clicks: map[string]int64{}}
+	clickCounter := clicks.New(store, clicks.Config{FlushInterval: time.Hour})
+	clickCounter.Add("abc")
+	clickCounter.Add("abc")
+
+	// A redirect still running when the drain times out.
+	release, started := make(chan struct{}), make(chan struct{})
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	}))
+	defer httpServer.Close()
+	defer close(release)
+	go http.Get(httpServer.URL)
+	<-started
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("SIGTERM was not delivered")
+	}
+
+	err := shutdown(50*time.Millisecond, 5*time.Second, httpServer.Config, nil, grpc.NewServer(), clickCounter)
+	if err == nil || !strings.Contains(err.Error(), "http server") {
+		t.Errorf("shutdown = %v, want the http drain to time out", err)
+	}
+	if strings.Contains(fmt.Sprint(err), "clicks") {
+		t.Errorf("shutdown = %v, want the clicks written", err)
+	}
+	if got := store.clicks["abc"]; got != 2 {
+		t.Errorf("clicks written = %d, want 2", got)
+	}
+}
+
+func TestQuotaLimitsRejectMalformedValues(t *testing.T) {
+	t.Setenv("QUOTA_MAX_ACTIVE_LINKS", "100")
+	t.Setenv("QUOTA_MAX_DAILY_LINKS", "")
+	limits, err := newQuotaLimits()
+	if err != nil || limits.MaxActiveLinks != 100 || limits.MaxDailyLinks != 0 {
+		t.Errorf("newQuotaLimits = %+v, %v, want 100 active links and no daily cap", limits, err)
+	}
+
+	for _, v := range []string{"10k", "-1", "1.5"} {
+		t.Setenv("QUOTA_MAX_DAILY_LINKS", v)
+		if _, err := newQuotaLimits(); err == nil {
+			t.Errorf("newQuotaLimits accepted QUOTA_MAX_DAILY_LINKS=%q", v)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+)
+
+// reservedAliases cannot be claimed as custom aliases because they clash
+// with routes served next to the redirects or are likely to be in future.
+var reservedAliases = map[string]bool{
+	"api":     true,
+	"admin":   true,
+	"healthz": true,
+	"metrics": true,
+	"s":       true,
+	"static":  true,
+}
+
+// validateAlias rejects reserved custom aliases. The allowed characters and
+// length are enforced by the rules in main.proto.
+func validateAlias(alias string) error {
+	if reservedAliases[strings.ToLower(alias)] {
+		return utils.InvalidArgument("custom_alias", fmt.Sprintf("%q is reserved", alias))
+	}
+	return nil
+}
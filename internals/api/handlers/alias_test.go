@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateAliasRejectsReservedWords(t *testing.T) {
+	for _, alias := range []string{"api", "Admin", "HEALTHZ", "metrics", "s", "static"} {
+		err := validateAlias(alias)
+		var fieldErr *utils.FieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Field != "custom_alias" {
+			t.Errorf("validateAlias(%q) = %v, want a custom_alias field error", alias, err)
+		}
+	}
+	for _, alias := range []string{"launch", "api-docs", "s2", "my_static"} {
+		if err := validateAlias(alias); err != nil {
+			t.Errorf("validateAlias(%q) = %v", alias, err)
+		}
+	}
+}
+
+func TestShortenURLWithCustomAlias(t *testing.T) {
+	s := newTestServer(t)
+	shorten := func(alias string) (*mainpb.ShortenURLResponse, error) {
+		return s.ShortenURL(as(owner), &mainpb.ShortenURLRequest{OriginalUrl: "https://example.com/", CustomAlias: alias})
+	}
+
+	resp, err := shorten("launch")
+	if err != nil {
+		t.Fatalf("ShortenURL = %v", err)
+	}
+	if resp.ShortId != "launch" || resp.ShortUrl != "http://localhost:8080/launch" {
+		t.Errorf("ShortenURL = %q, %q", resp.ShortId, resp.ShortUrl)
+	}
+
+	_, err = shorten("launch")
+	wantCode(t, "ShortenURL with a taken alias", err, codes.AlreadyExists)
+	_, err = shorten("mine")
+	wantCode(t, "ShortenURL with another owner's alias", err, codes.AlreadyExists)
+	_, err = shorten("Admin")
+	wantCode(t, "ShortenURL with a reserved alias", err, codes.InvalidArgument)
+	// Expired links free their alias.
+	_, err = shorten("old")
+	wantCode(t, "ShortenURL with an expired link's alias", err, codes.OK)
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyIDAttempts bounds retries when a freshly generated key id collides.
+const keyIDAttempts = 3
+
+var errKeysUnsupported = status.Error(codes.Unimplemented, "api keys are not supported by this storage backend")
+
+// CreateAPIKey issues a new API key. The plaintext key is returned once and
+// only its hash is stored.
+func (s *Server) CreateAPIKey(ctx context.Context, req *mainpb.CreateAPIKeyRequest) (*mainpb.CreateAPIKeyResponse, error) {
+	if s.Keys == nil {
+		return nil, errKeysUnsupported
+	}
+	for _, name := range req.Scopes {
+		if _, err := auth.ParseScope(name); err != nil {
+			return nil, utils.ErrorHandler(utils.InvalidArgument("scopes", err.Error()), "invalid scopes")
+		}
+	}
+
+	now := time.Now()
+	var (
+		plaintext string
+		err       error
+	)
+	key := &models.APIKey{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		CreatedAt: now,
+	}
+	for range keyIDAttempts {
+		key.ID, plaintext = auth.NewAPIKey()
+		key.Hash = auth.HashAPIKey(plaintext)
+		err = s.Keys.CreateAPIKey(ctx, key)
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to create api key")
+	}
+
+	return &mainpb.CreateAPIKeyResponse{
+		KeyId:     key.ID,
+		ApiKey:    plaintext,
+		Scopes:    key.Scopes,
+		CreatedAt: now.Format(time.RFC3339),
+	}, nil
+}
+
+// RevokeAPIKey revokes a key; requests made with it are rejected from then
+// on.
+func (s *Server) RevokeAPIKey(ctx context.Context, req *mainpb.RevokeAPIKeyRequest) (*mainpb.RevokeAPIKeyResponse, error) {
+	if s.Keys == nil {
+		return nil, errKeysUnsupported
+	}
+	if err := s.Keys.RevokeAPIKey(ctx, req.KeyId, time.Now().Unix()); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to revoke api key "+req.KeyId)
+	}
+
+	return &mainpb.RevokeAPIKeyResponse{Success: true, Message: "API key revoked successfully"}, nil
+}
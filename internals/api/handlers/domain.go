@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// verificationRecordPrefix is prepended to a domain to name the TXT
+	// record proving ownership of it.
+	verificationRecordPrefix = "_shortener-challenge."
+	// verificationValuePrefix is prepended to the token in the TXT record.
+	verificationValuePrefix = "shortener-verification="
+)
+
+var errDomainsUnsupported = status.Error(codes.Unimplemented, "custom domains are not supported by this storage backend")
+
+// normalizeDomain returns a host name in the form URL validation stores
+// hosts in, see validation.NormalizeHost.
+func normalizeDomain(name string) (string, error) {
+	ascii, err := validation.NormalizeHost(name)
+	if err != nil {
+		return "", utils.InvalidArgument("domain", fmt.Sprintf("%s is not a valid domain name", name))
+	}
+	return ascii, nil
+}
+
+// linkDomain returns the custom domain a new link is bound to, "" for the
+// primary domain. Custom domains must be registered, usable by the caller,
+// see authorizeDomain, and verified.
+func (s *Server) linkDomain(ctx context.Context, name string) (string, error) {
+	name, err := normalizeDomain(name)
+	if err != nil {
+		return "", err
+	}
+	if name == "" || s.links().IsPrimary(name) {
+		return "", nil
+	}
+	if s.Domains == nil {
+		return "", errDomainsUnsupported
+	}
+
+	d, err := s.Domains.GetDomain(ctx, name)
+	if errors.Is(err, repository.ErrDomainNotFound) {
+		return "", utils.InvalidArgument("domain", fmt.Sprintf("%s is not a registered domain", name))
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := s.authorizeDomain(ctx, d); err != nil {
+		return "", err
+	}
+	if !d.Verified() {
+		return "", status.Errorf(codes.FailedPrecondition, "domain %s has not been verified", name)
+	}
+	return name, nil
+}
+
+// authorizeDomain checks that the caller may create links on d: its
+// creator, editors of the workspace it was registered for, and callers that
+// see all links.
+func (s *Server) authorizeDomain(ctx context.Context, d *models.Domain) error {
+	switch {
+	case seesAllLinks(ctx):
+		return nil
+	case d.CreatedBy != "" && d.CreatedBy == callerID(ctx):
+		return nil
+	case d.WorkspaceID != "":
+		err := s.requireRole(ctx, d.WorkspaceID, models.RoleEditor)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, repository.ErrWorkspaceNotFound) && status.Code(err) == codes.Unknown {
+			return err
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "not allowed to use domain %s", d.Name)
+}
+
+// rejectSelfLink rejects a normalised destination on a verified custom
+// domain: like the primary domain, see URLValidator.SelfHosts, it would
+// redirect back to the shortener.
+func (s *Server) rejectSelfLink(ctx context.Context, field, dest string) error {
+	if s.Domains == nil {
+		return nil
+	}
+	u, err := url.Parse(dest)
+	if err != nil {
+		return err
+	}
+	name, err := normalizeDomain(u.Hostname())
+	if err != nil {
+		return nil // IP literal or otherwise no domain name
+	}
+	d, err := s.Domains.GetDomain(ctx, name)
+	if errors.Is(err, repository.ErrDomainNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if d.Verified() {
+		return utils.InvalidArgument(field, "must not point at the shortener itself")
+	}
+	return nil
+}
+
+func (s *Server) lookupTXT(ctx context.Context, name string) ([]string, error) {
+	if s.LookupTXT != nil {
+		return s.LookupTXT(ctx, name)
+	}
+	return net.DefaultResolver.LookupTXT(ctx, name)
+}
+
+func toRegisterDomainResponse(d *models.Domain) *mainpb.RegisterDomainResponse {
+	return &mainpb.RegisterDomainResponse{
+		Domain:                  d.Name,
+		VerificationRecordName:  verificationRecordPrefix + d.Name,
+		VerificationRecordValue: verificationValuePrefix + d.VerificationToken,
+		Verified:                d.Verified(),
+		WorkspaceId:             d.WorkspaceID,
+	}
+}
+
+// RegisterDomain adds a custom domain and returns the DNS TXT record that
+// proves ownership of it. A domain registered for a workspace may be used
+// by the workspace's editors, otherwise only by the caller. Registering a
+// domain again returns the same record to those who may use it.
+func (s *Server) RegisterDomain(ctx context.Context, req *mainpb.RegisterDomainRequest) (*mainpb.RegisterDomainResponse, error) {
+	if s.Domains == nil {
+		return nil, errDomainsUnsupported
+	}
+	name, err := normalizeDomain(req.Domain)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "invalid domain")
+	}
+	if s.links().IsPrimary(name) {
+		return nil, utils.ErrorHandler(utils.InvalidArgument("domain", "the primary domain cannot be registered as a custom domain"), "invalid domain")
+	}
+	if req.WorkspaceId != "" {
+		if err := s.requireRole(ctx, req.WorkspaceId, models.RoleAdmin); err != nil {
+			return nil, utils.ErrorHandler(err, "cannot register domains for workspace "+req.WorkspaceId)
+		}
+	}
+
+	d := &models.Domain{
+		Name:              name,
+		VerificationToken: rand.Text(),
+		CreatedAt:         time.Now(),
+		CreatedBy:         callerID(ctx),
+		WorkspaceID:       req.WorkspaceId,
+	}
+	err = s.Domains.CreateDomain(ctx, d)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		d, err = s.Domains.GetDomain(ctx, name)
+		if err == nil {
+			err = s.authorizeDomain(ctx, d)
+		}
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to register domain "+name)
+	}
+	return toRegisterDomainResponse(d), nil
+}
+
+// VerifyDomain looks up the domain's TXT record and, if it holds the
+// verification token, allows links to be created on the domain.
+func (s *Server) VerifyDomain(ctx context.Context, req *mainpb.VerifyDomainRequest) (*mainpb.VerifyDomainResponse, error) {
+	if s.Domains == nil {
+		return nil, errDomainsUnsupported
+	}
+	name, err := normalizeDomain(req.Domain)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "invalid domain")
+	}
+
+	d, err := s.Domains.GetDomain(ctx, name)
+	if err == nil {
+		err = s.authorizeDomain(ctx, d)
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to verify domain "+name)
+	}
+	if !d.Verified() {
+		record := verificationRecordPrefix + name
+		values, err := s.lookupTXT(ctx, record)
+		var dnsErr *net.DNSError
+		if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+			return nil, status.Errorf(codes.Unavailable, "failed to look up TXT record %s", record)
+		}
+		if !slices.Contains(values, verificationValuePrefix+d.VerificationToken) {
+			return nil, status.Errorf(codes.FailedPrecondition, "TXT record %s does not contain the verification value yet", record)
+		}
+
+		d.VerifiedAt = time.Now().Unix()
+		if err := s.Domains.VerifyDomain(ctx, name, d.VerifiedAt); err != nil {
+			return nil, utils.ErrorHandler(err, "failed to verify domain "+name)
+		}
+	}
+
+	return &mainpb.VerifyDomainResponse{
+		Domain:     d.Name,
+		Verified:   true,
+		VerifiedAt: d.VerifiedAt,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+)
+
+// newDomainTestServer extends newTestServer with the verified domains
+// "team.example", registered for workspace TEAM, and "solo.example", and
+// the unverified "new.example", all registered by admin.
+func newDomainTestServer(t *testing.T) *Server {
+	t.Helper()
+	s := newTestServer(t)
+	s.Domains = s.Store.(repository.DomainStore)
+
+	verified := time.Now().Unix()
+	for _, d := range []*models.Domain{
+		{Name: "team.example", CreatedBy: admin.Subject, WorkspaceID: "TEAM", VerifiedAt: verified},
+		{Name: "solo.example", CreatedBy: admin.Subject, VerifiedAt: verified},
+		{Name: "new.example", CreatedBy: admin.Subject, WorkspaceID: "TEAM"},
+	} {
+		if err := s.Domains.CreateDomain(context.Background(), d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestLinkDomainRequiresAccess(t *testing.T) {
+	s := newDomainTestServer(t)
+	shorten := func(ctx context.Context, domain string) error {
+		_, err := s.ShortenURL(ctx, &mainpb.ShortenURLRequest{OriginalUrl: "https://example.com/", Domain: domain})
+		return err
+	}
+
+	wantCode(t, "workspace domain by workspace admin", shorten(as(owner), "team.example"), codes.OK)
+	wantCode(t, "workspace domain by workspace viewer", shorten(as(viewer), "team.example"), codes.PermissionDenied)
+	wantCode(t, "workspace domain by stranger", shorten(as(stranger), "team.example"), codes.PermissionDenied)
+	wantCode(t, "workspace domain by admin", shorten(as(admin), "team.example"), codes.OK)
+	wantCode(t, "personal domain by someone else", shorten(as(owner), "solo.example"), codes.PermissionDenied)
+	wantCode(t, "personal domain by admin", shorten(as(admin), "SOLO.example."), codes.OK)
+	wantCode(t, "unverified domain", shorten(as(owner), "new.example"), codes.FailedPrecondition)
+	wantCode(t, "unregistered domain", shorten(as(owner), "other.example"), codes.InvalidArgument)
+}
+
+func TestRegisterDomainForWorkspace(t *testing.T) {
+	s := newDomainTestServer(t)
+
+	resp, err := s.RegisterDomain(as(admin), &mainpb.RegisterDomainRequest{Domain: "links.example", WorkspaceId: "TEAM"})
+	if err != nil {
+		t.Fatalf("RegisterDomain = %v", err)
+	}
+	if resp.WorkspaceId != "TEAM" {
+		t.Errorf("RegisterDomain workspace = %q, want TEAM", resp.WorkspaceId)
+	}
+	d, err := s.Domains.GetDomain(context.Background(), "links.example")
+	if err != nil || d.WorkspaceID != "TEAM" {
+		t.Errorf("stored domain = %+v, %v", d, err)
+	}
+
+	_, err = s.RegisterDomain(as(admin), &mainpb.RegisterDomainRequest{Domain: "other.example", WorkspaceId: "NOPE"})
+	wantCode(t, "RegisterDomain for an unknown workspace", err, codes.NotFound)
+
+	// RegisterDomain only needs the write scope, so the workspace role
+	// decides who may register domains for a workspace.
+	_, err = s.RegisterDomain(as(owner), &mainpb.RegisterDomainRequest{Domain: "owner.example", WorkspaceId: "TEAM"})
+	wantCode(t, "RegisterDomain by workspace admin", err, codes.OK)
+	_, err = s.RegisterDomain(as(viewer), &mainpb.RegisterDomainRequest{Domain: "viewer.example", WorkspaceId: "TEAM"})
+	wantCode(t, "RegisterDomain by workspace viewer", err, codes.PermissionDenied)
+	_, err = s.RegisterDomain(as(stranger), &mainpb.RegisterDomainRequest{Domain: "stranger.example"})
+	wantCode(t, "RegisterDomain of a personal domain", err, codes.OK)
+
+	_, err = s.RegisterDomain(as(stranger), &mainpb.RegisterDomainRequest{Domain: "new.example"})
+	wantCode(t, "RegisterDomain of someone else's domain", err, codes.PermissionDenied)
+	_, err = s.VerifyDomain(as(stranger), &mainpb.VerifyDomainRequest{Domain: "new.example"})
+	wantCode(t, "VerifyDomain of someone else's domain", err, codes.PermissionDenied)
+}
+
+func TestLinksCannotPointAtCustomDomains(t *testing.T) {
+	s := newDomainTestServer(t)
+
+	_, err := s.ShortenURL(as(owner), &mainpb.ShortenURLRequest{OriginalUrl: "https://Team.Example/launch"})
+	wantCode(t, "ShortenURL to a verified custom domain", err, codes.InvalidArgument)
+	_, err = s.ShortenURL(as(owner), &mainpb.ShortenURLRequest{OriginalUrl: "https://solo.example:8443/x"})
+	wantCode(t, "ShortenURL to a verified custom domain with port", err, codes.InvalidArgument)
+	_, err = s.UpdateURL(as(owner), &mainpb.UpdateURLRequest{ShortId: "mine", NewOriginalUrl: "https://team.example/"})
+	wantCode(t, "UpdateURL to a verified custom domain", err, codes.InvalidArgument)
+
+	// Anyone can register a domain, so unverified ones must not block links.
+	_, err = s.ShortenURL(as(owner), &mainpb.ShortenURLRequest{OriginalUrl: "https://new.example/"})
+	wantCode(t, "ShortenURL to an unverified domain", err, codes.OK)
+}
+
+func TestInternationalDomainsAreStoredAsPunycode(t *testing.T) {
+	s := newDomainTestServer(t)
+
+	resp, err := s.RegisterDomain(as(admin), &mainpb.RegisterDomainRequest{Domain: "Bücher.Example."})
+	if err != nil {
+		t.Fatalf("RegisterDomain = %v", err)
+	}
+	if resp.Domain != "xn--bcher-kva.example" {
+		t.Errorf("RegisterDomain domain = %q, want the punycode form", resp.Domain)
+	}
+	if err := s.Domains.VerifyDomain(context.Background(), "xn--bcher-kva.example", time.Now().Unix()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validation stores destination hosts as punycode, so either spelling
+	// of the domain is recognised.
+	_, err = s.ShortenURL(as(owner), &mainpb.ShortenURLRequest{OriginalUrl: "https://bücher.example/x"})
+	wantCode(t, "ShortenURL to a verified international domain", err, codes.InvalidArgument)
+	_, err = s.ShortenURL(as(admin), &mainpb.ShortenURLRequest{OriginalUrl: "https://example.com/", Domain: "BÜCHER.example"})
+	wantCode(t, "ShortenURL on an international domain", err, codes.OK)
+
+	_, err = s.RegisterDomain(as(admin), &mainpb.RegisterDomainRequest{Domain: "-bad.example"})
+	wantCode(t, "RegisterDomain with an invalid name", err, codes.InvalidArgument)
+}
+
+func TestListingPagesPastLongCustomDomainLinks(t *testing.T) {
+	s := newDomainTestServer(t)
+
+	// short ids on custom domains run up to 320 characters, and page keys
+	// are short ids
+	label := strings.Repeat("a", 63)
+	domain := strings.Join([]string{label, label, label, label[:50], "example"}, ".")
+	want := []string{"mine", "team"}
+	for _, id := range []string{"launch-2026", "zz-" + strings.Repeat("x", 60)} {
+		shortID := models.JoinShortID(domain, id)
+		u := &models.URL{ShortID: shortID, OriginalURL: "https://example.com/" + id, OwnerID: owner.Subject}
+		if err := s.Store.Create(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, shortID)
+	}
+	slices.Sort(want)
+	if len(want[0]) <= 256 {
+		t.Fatalf("short id %q is not longer than 256 characters", want[0])
+	}
+
+	var got []string
+	req := &mainpb.ListMyURLsRequest{Limit: 1}
+	for range len(want) + 1 {
+		if err := req.Validate(); err != nil {
+			t.Fatalf("ListMyURLs request after %q: %v", req.LastEvaluatedKey, err)
+		}
+		resp, err := s.ListMyURLs(as(owner), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, u := range resp.Urls {
+			got = append(got, u.ShortId)
+		}
+		if resp.LastEvaluatedKey == "" {
+			break
+		}
+		req.LastEvaluatedKey = resp.LastEvaluatedKey
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ListMyURLs pages = %q, want %q", got, want)
+	}
+
+	if err := (&mainpb.ListAllURLsRequest{LastEvaluatedKey: want[0]}).Validate(); err != nil {
+		t.Errorf("ListAllURLs request with a long page key: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+)
+
+// maxLinkLifetime bounds how far in the future a link may be set to expire
+// (10 years).
+const maxLinkLifetime = 10 * 365 * 24 * time.Hour
+
+// resolveExpiry turns the relative and absolute expiry fields of a request
+// into a unix expire_at. At most one of them may be set; when neither is,
+// the link never expires and 0 is returned. Validation errors name the
+// offending request field; negative values are already rejected by the
+// rules in main.proto.
+func resolveExpiry(now time.Time, inSeconds, at int64, secondsField, atField string) (int64, error) {
+	switch {
+	case inSeconds > 0 && at != 0:
+		return 0, utils.InvalidArgument(atField, "cannot be combined with "+secondsField)
+	case inSeconds > 0:
+		if time.Duration(inSeconds) > maxLinkLifetime/time.Second {
+			return 0, utils.InvalidArgument(secondsField, "must be at most 10 years")
+		}
+		return now.Add(time.Duration(inSeconds) * time.Second).Unix(), nil
+	case at != 0:
+		if at <= now.Unix() {
+			return 0, utils.InvalidArgument(atField, "must be in the future")
+		}
+		if at > now.Add(maxLinkLifetime).Unix() {
+			return 0, utils.InvalidArgument(atField, "must be at most 10 years away")
+		}
+		return at, nil
+	default:
+		return 0, nil
+	}
+}
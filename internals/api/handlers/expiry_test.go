@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+)
+
+func TestResolveExpiry(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	maxSeconds := int64(maxLinkLifetime / time.Second)
+
+	tests := []struct {
+		name      string
+		inSeconds int64
+		at        int64
+		want      int64
+		wantField string
+	}{
+		{name: "never", want: 0},
+		{name: "relative", inSeconds: 60, want: now.Unix() + 60},
+		{name: "relative at the limit", inSeconds: maxSeconds, want: now.Unix() + maxSeconds},
+		{name: "relative past the limit", inSeconds: maxSeconds + 1, wantField: "in"},
+		{name: "absolute", at: now.Unix() + 1, want: now.Unix() + 1},
+		{name: "absolute at the limit", at: now.Unix() + maxSeconds, want: now.Unix() + maxSeconds},
+		{name: "absolute past the limit", at: now.Unix() + maxSeconds + 1, wantField: "at"},
+		{name: "absolute now", at: now.Unix(), wantField: "at"},
+		{name: "absolute in the past", at: now.Unix() - 1, wantField: "at"},
+		{name: "both", inSeconds: 60, at: now.Unix() + 60, wantField: "at"},
+	}
+	for _, tt := range tests {
+		got, err := resolveExpiry(now, tt.inSeconds, tt.at, "in", "at")
+		if tt.wantField == "" {
+			if err != nil || got != tt.want {
+				t.Errorf("%s: resolveExpiry = %d, %v, want %d", tt.name, got, err, tt.want)
+			}
+			continue
+		}
+		var fieldErr *utils.FieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Field != tt.wantField {
+			t.Errorf("%s: resolveExpiry = %d, %v, want a %s field error", tt.name, got, err, tt.wantField)
+		}
+	}
+}
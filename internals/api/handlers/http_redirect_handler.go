@@ -1,19 +1,67 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"html/template"
+	"log"
 	"net/http"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/screening"
 )
 
-// RedirectHandler returns an HTTP handler function for short URLs
-func RedirectHandler(getLongURL func(shortKey string) (string, bool)) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		shortKey := r.URL.Path[1:] // remove leading "/"
+// warningPage is served instead of a redirect for flagged links.
+var warningPage = template.Must(template.New("warning").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><meta name="robots" content="noindex"><title>Warning: suspicious link</title></head>
+<body>
+<h1>This link may be unsafe</h1>
+<p>The destination of this short link has been flagged as possibly malicious.
+Only continue if you trust where it leads.</p>
+<p><code>{{.}}</code></p>
+<p><a href="{{.}}" rel="noopener noreferrer nofollow">Continue anyway</a></p>
+</body>
+</html>
+`))
 
-		if longURL, ok := getLongURL(shortKey); ok {
-			http.Redirect(w, r, longURL, http.StatusFound) // 302 redirect
+// RedirectHandler returns an HTTP handler function for short URLs served
+// under links.Prefix, on the primary domain or a custom one
+func RedirectHandler(links *PublicLinks, getLongURL func(ctx context.Context, shortKey string) (*models.URL, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := links.ShortID(r.URL.Path)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		shortKey, err := links.Key(r.Context(), r.Host, id)
+		if err != nil {
+			log.Printf("failed to look up domain %s: %v", r.Host, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 			return
 		}
 
-		http.NotFound(w, r)
+		url, err := getLongURL(r.Context(), shortKey)
+		switch {
+		case err == nil && url.Flagged:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusOK)
+			if err := warningPage.Execute(w, url.OriginalURL); err != nil {
+				log.Printf("failed to render warning page for %s: %v", shortKey, err)
+			}
+		case err == nil:
+			http.Redirect(w, r, url.OriginalURL, http.StatusFound) // 302 redirect
+		case errors.Is(err, repository.ErrExpired):
+			http.Error(w, "This link has expired", http.StatusGone)
+		case errors.Is(err, repository.ErrNotFound):
+			http.NotFound(w, r)
+		case errors.Is(err, screening.ErrBlocked):
+			http.Error(w, "This link has been disabled because its destination is blocked", http.StatusForbidden)
+		default:
+			log.Printf("failed to resolve %s: %v", shortKey, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
 	}
 }
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+	"github.com/aayushxrj/aws-url-shortner/internals/screening"
+)
+
+func TestRedirectHandler(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	for _, u := range []*models.URL{
+		{ShortID: "live", OriginalURL: "https://example.com/live"},
+		// Expired but still stored, as until the backend purges it.
+		{ShortID: "old", OriginalURL: "https://example.com/old", ExpireAt: time.Now().Add(-time.Minute).Unix()},
+	} {
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handler := RedirectHandler(defaultLinks, store.Get)
+
+	tests := []struct {
+		path     string
+		want     int
+		location string
+	}{
+		{"/live", http.StatusFound, "https://example.com/live"},
+		{"/old", http.StatusGone, ""},
+		{"/missing", http.StatusNotFound, ""},
+		{"/", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "http://localhost:8080"+tt.path, nil))
+		if rec.Code != tt.want || rec.Header().Get("Location") != tt.location {
+			t.Errorf("GET %s = %d, Location %q, want %d, %q", tt.path, rec.Code, rec.Header().Get("Location"), tt.want, tt.location)
+		}
+	}
+}
+
+func TestRedirectHandlerStopsAtFlaggedAndBlockedLinks(t *testing.T) {
+	handler := RedirectHandler(defaultLinks, func(ctx context.Context, shortKey string) (*models.URL, error) {
+		if shortKey == "blocked" {
+			return nil, screening.ErrBlocked
+		}
+		return &models.URL{ShortID: shortKey, OriginalURL: "javascript:alert(1)", Flagged: true}, nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "http://localhost:8080/flagged", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Location") != "" {
+		t.Errorf("flagged link = %d, Location %q, want a 200 warning page", rec.Code, rec.Header().Get("Location"))
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("warning page Cache-Control = %q, want no-store", got)
+	}
+	if body := rec.Body.String(); strings.Contains(body, `href="javascript:`) || !strings.Contains(body, `href="#ZgotmplZ"`) {
+		t.Errorf("warning page links to an unsafe destination:\n%s", body)
+	}
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "http://localhost:8080/blocked", nil))
+	if rec.Code != http.StatusForbidden || rec.Header().Get("Location") != "" {
+		t.Errorf("blocked link = %d, Location %q, want 403", rec.Code, rec.Header().Get("Location"))
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// PublicLinks describes where short links are served: the redirect server
+// mounts them under Prefix, and ShortenURL returns BaseURL + Prefix + id.
+// Links on a custom domain keep everything but the host of BaseURL, scheme
+// and path included, so a proxy in front of the redirect server must route
+// custom domains the same way as the primary one.
+type PublicLinks struct {
+	// BaseURL is the public origin of the redirect server, possibly with a
+	// path added by a reverse proxy, without a trailing slash.
+	BaseURL string
+	// Prefix is the path links live under on the redirect server. It starts
+	// and ends with "/".
+	Prefix string
+	// Hosts are further host names, with or without port, that serve the
+	// primary domain, such as internal addresses. They can never be
+	// registered as custom domains.
+	Hosts []string
+	// Domains holds the custom domains. Requests for a verified one
+	// resolve links bound to it; every other host serves the primary
+	// domain. Without it only the primary domain is served.
+	Domains repository.DomainStore
+}
+
+// defaultLinks matches the redirect server's default port.
+var defaultLinks = &PublicLinks{BaseURL: "http://localhost:8080", Prefix: "/"}
+
+// NewPublicLinks validates baseURL, an absolute http(s) URL, and prefix, a
+// path such as "/" or "/s".
+func NewPublicLinks(baseURL, prefix string) (*PublicLinks, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public base URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("public base URL %q must be an absolute http or https URL", baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, fmt.Errorf("public base URL %q must not have credentials, a query or a fragment", baseURL)
+	}
+
+	prefix = "/" + strings.Trim(prefix, "/")
+	if prefix != "/" {
+		prefix += "/"
+	}
+	if strings.ContainsAny(prefix, "?#%") || strings.Contains(prefix, "//") {
+		return nil, fmt.Errorf("invalid short URL prefix %q", prefix)
+	}
+
+	return &PublicLinks{BaseURL: strings.TrimRight(u.String(), "/"), Prefix: prefix}, nil
+}
+
+// Host returns the host and port of BaseURL.
+func (l *PublicLinks) Host() string {
+	u, err := url.Parse(l.BaseURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// IsPrimary reports whether host, with or without port, serves the
+// primary domain rather than a custom one.
+func (l *PublicLinks) IsPrimary(host string) bool {
+	name := hostname(host)
+	if strings.EqualFold(hostname(l.Host()), name) {
+		return true
+	}
+	for _, h := range l.Hosts {
+		if strings.EqualFold(hostname(h), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Key returns the short id a request for id on host resolves to: the link
+// bound to host if it is a verified custom domain, otherwise the primary
+// domain's link.
+func (l *PublicLinks) Key(ctx context.Context, host, id string) (string, error) {
+	if l.Domains == nil || l.IsPrimary(host) {
+		return id, nil
+	}
+	name, err := normalizeDomain(hostname(host))
+	if err != nil {
+		// no domain of that name can have been registered
+		return id, nil
+	}
+	d, err := l.Domains.GetDomain(ctx, name)
+	if errors.Is(err, repository.ErrDomainNotFound) {
+		return id, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !d.Verified() {
+		return id, nil
+	}
+	return models.JoinShortID(d.Name, id), nil
+}
+
+// URL returns the public URL of shortID.
+func (l *PublicLinks) URL(shortID string) string {
+	domain, id := models.SplitShortID(shortID)
+	if domain == "" {
+		return l.BaseURL + l.Prefix + url.PathEscape(id)
+	}
+	u, err := url.Parse(l.BaseURL)
+	if err != nil {
+		return ""
+	}
+	u.Host = domain
+	return strings.TrimRight(u.String(), "/") + l.Prefix + url.PathEscape(id)
+}
+
+// ShortID extracts the short id from a request path under Prefix.
+func (l *PublicLinks) ShortID(path string) (string, bool) {
+	id, ok := strings.CutPrefix(path, l.Prefix)
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+// hostname strips the port from host.
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
+
+func (s *Server) links() *PublicLinks {
+	if s.Links != nil {
+		return s.Links
+	}
+	return defaultLinks
+}
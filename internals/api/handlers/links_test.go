@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+)
+
+func TestPublicLinksURL(t *testing.T) {
+	tests := []struct {
+		baseURL, prefix, shortID string
+		want                     string
+	}{
+		{"http://localhost:8080", "/", "abc", "http://localhost:8080/abc"},
+		{"http://localhost:8080", "/", models.JoinShortID("go.acme.com", "abc"), "http://go.acme.com/abc"},
+		{"https://acme.com/go/", "/s", "abc", "https://acme.com/go/s/abc"},
+		{"https://acme.com/go", "s", models.JoinShortID("go.acme.com", "abc"), "https://go.acme.com/go/s/abc"},
+	}
+	for _, tt := range tests {
+		links, err := NewPublicLinks(tt.baseURL, tt.prefix)
+		if err != nil {
+			t.Fatalf("NewPublicLinks(%q, %q) = %v", tt.baseURL, tt.prefix, err)
+		}
+		if got := links.URL(tt.shortID); got != tt.want {
+			t.Errorf("NewPublicLinks(%q, %q).URL(%q) = %q, want %q", tt.baseURL, tt.prefix, tt.shortID, got, tt.want)
+		}
+	}
+}
+
+func TestPublicLinksResolveCustomDomains(t *testing.T) {
+	links, err := NewPublicLinks("https://acme.com/go", "/s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	links.Hosts = []string{"127.0.0.1:8080"}
+	store := db.NewMemoryStore()
+	for _, d := range []*models.Domain{
+		{Name: "go.acme.com", VerifiedAt: time.Now().Unix()},
+		{Name: "new.acme.com"},
+	} {
+		if err := store.CreateDomain(context.Background(), d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links.Domains = store
+
+	id, ok := links.ShortID("/s/launch")
+	if !ok || id != "launch" {
+		t.Fatalf("ShortID = %q, %v", id, ok)
+	}
+	tests := []struct {
+		name, host, want string
+	}{
+		{"the primary domain", "acme.com", "launch"},
+		{"a primary host", "127.0.0.1:8080", "launch"},
+		{"a custom domain", "Go.Acme.com:443", models.JoinShortID("go.acme.com", "launch")},
+		{"an unverified custom domain", "new.acme.com", "launch"},
+		{"an unlisted host", "10.0.0.7:8080", "launch"},
+		{"an unlisted domain", "lb.internal.example", "launch"},
+	}
+	for _, tt := range tests {
+		got, err := links.Key(context.Background(), tt.host, id)
+		if err != nil || got != tt.want {
+			t.Errorf("Key on %s = %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+	if _, ok := links.ShortID("/launch"); ok {
+		t.Error("ShortID accepted a path outside the prefix")
+	}
+}
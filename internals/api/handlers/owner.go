@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// callerID returns the subject of the authenticated caller, or "" when the
+// API runs without authentication.
+func callerID(ctx context.Context) string {
+	if p, ok := auth.FromContext(ctx); ok {
+		return p.Subject
+	}
+	return ""
+}
+
+// seesAllLinks reports whether the caller may manage links it does not own:
+// admins, and everyone when the API runs without authentication.
+func seesAllLinks(ctx context.Context) bool {
+	p, ok := auth.FromContext(ctx)
+	return !ok || p.Allows(auth.ScopeAdmin)
+}
+
+// checkAccess hides url from callers that may not use it with at least the
+// given workspace role. Callers that see all links may use every link;
+// otherwise workspace links follow the caller's membership and personal
+// links are only visible to their owner. Links the caller cannot see are
+// reported as not found, so that short ids cannot be probed.
+func (s *Server) checkAccess(ctx context.Context, url *models.URL, min models.Role) error {
+	switch {
+	case seesAllLinks(ctx):
+		return nil
+	case url.WorkspaceID != "":
+		err := s.requireRole(ctx, url.WorkspaceID, min)
+		if errors.Is(err, repository.ErrWorkspaceNotFound) {
+			return repository.ErrNotFound
+		}
+		return err
+	case url.OwnerID != "" && url.OwnerID == callerID(ctx):
+		return nil
+	}
+	return repository.ErrNotFound
+}
+
+// authorizeLink loads shortID and checks the caller may use it with at least
+// min. It is the access check of every RPC that takes a short id. Expired
+// links keep their owner until the store purges or reuses them, so they are
+// returned too, for the owner to see, extend or delete; RPCs that resolve
+// links report them as expired themselves.
+func (s *Server) authorizeLink(ctx context.Context, shortID string, min models.Role) (*models.URL, error) {
+	url, err := s.Store.Get(ctx, shortID)
+	if err != nil && !errors.Is(err, repository.ErrExpired) {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, url, min); err != nil {
+		return nil, err
+	}
+	return url, nil
+}
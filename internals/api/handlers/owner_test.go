@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	owner    = &auth.Principal{Subject: auth.KeySubject("OWNER"), Scopes: []auth.Scope{auth.ScopeWrite}}
+	stranger = &auth.Principal{Subject: auth.KeySubject("STRANGER"), Scopes: []auth.Scope{auth.ScopeWrite}}
+	viewer   = &auth.Principal{Subject: auth.KeySubject("VIEWER"), Scopes: []auth.Scope{auth.ScopeWrite}}
+	admin    = &auth.Principal{Subject: auth.KeySubject("ADMIN"), Scopes: []auth.Scope{auth.ScopeAdmin}}
+)
+
+// newTestServer returns a Server over a memory store holding a personal
+// link "mine" of owner, an expired one "old", and a link "team" in a
+// workspace where owner is admin and viewer a viewer.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+
+	err := store.CreateWorkspace(ctx,
+		&models.Workspace{ID: "TEAM", Name: "team", CreatedBy: owner.Subject},
+		&models.Member{WorkspaceID: "TEAM", UserID: owner.Subject, Role: models.RoleAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutMember(ctx, &models.Member{WorkspaceID: "TEAM", UserID: viewer.Subject, Role: models.RoleViewer}); err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range []*models.URL{
+		{ShortID: "mine", OriginalURL: "https://example.com/mine", OwnerID: owner.Subject},
+		{ShortID: "old", OriginalURL: "https://example.com/old", OwnerID: owner.Subject, ExpireAt: time.Now().Add(-time.Hour).Unix()},
+		{ShortID: "team", OriginalURL: "https://example.com/team", OwnerID: owner.Subject, WorkspaceID: "TEAM"},
+	} {
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Server{Store: store, Workspaces: store}
+}
+
+func as(p *auth.Principal) context.Context {
+	return auth.NewContext(context.Background(), p)
+}
+
+func wantCode(t *testing.T, name string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("%s: code %v (%v), want %v", name, got, err, want)
+	}
+}
+
+func TestLinkRPCsShareOneAccessRule(t *testing.T) {
+	s := newTestServer(t)
+
+	calls := map[string]func(ctx context.Context, id string) error{
+		"GetOriginalURL": func(ctx context.Context, id string) error {
+			_, err := s.GetOriginalURL(ctx, &mainpb.GetOriginalURLRequest{ShortId: id})
+			return err
+		},
+		"IncrementClick": func(ctx context.Context, id string) error {
+			_, err := s.IncrementClick(ctx, &mainpb.IncrementClickRequest{ShortId: id})
+			return err
+		},
+		"GetURLStats": func(ctx context.Context, id string) error {
+			_, err := s.GetURLStats(ctx, &mainpb.GetURLStatsRequest{ShortId: id})
+			return err
+		},
+		"UpdateURL": func(ctx context.Context, id string) error {
+			_, err := s.UpdateURL(ctx, &mainpb.UpdateURLRequest{ShortId: id, NewOriginalUrl: "https://example.com/new"})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		wantCode(t, name+" by owner", call(as(owner), "mine"), codes.OK)
+		wantCode(t, name+" by admin", call(as(admin), "mine"), codes.OK)
+		wantCode(t, name+" by stranger", call(as(stranger), "mine"), codes.NotFound)
+		wantCode(t, name+" on workspace link by stranger", call(as(stranger), "team"), codes.NotFound)
+		wantCode(t, name+" on workspace link by admin", call(as(admin), "team"), codes.OK)
+		wantCode(t, name+" of unknown link", call(as(owner), "nope"), codes.NotFound)
+	}
+
+	wantCode(t, "GetURLStats by viewer", calls["GetURLStats"](as(viewer), "team"), codes.OK)
+	wantCode(t, "UpdateURL by viewer", calls["UpdateURL"](as(viewer), "team"), codes.PermissionDenied)
+	wantCode(t, "IncrementClick by viewer", calls["IncrementClick"](as(viewer), "team"), codes.PermissionDenied)
+}
+
+func TestAdminsSeeWorkspaceLinksWithoutWorkspaceStore(t *testing.T) {
+	s := newTestServer(t)
+	s.Workspaces = nil
+
+	if _, err := s.GetURLStats(as(admin), &mainpb.GetURLStatsRequest{ShortId: "team"}); err != nil {
+		t.Errorf("GetURLStats by admin = %v", err)
+	}
+	if _, err := s.DeleteURL(as(admin), &mainpb.DeleteURLRequest{ShortId: "team"}); err != nil {
+		t.Errorf("DeleteURL by admin = %v", err)
+	}
+}
+
+func TestOwnersCanReviveExpiredLinks(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := s.GetOriginalURL(as(owner), &mainpb.GetOriginalURLRequest{ShortId: "old"})
+	wantCode(t, "GetOriginalURL of an expired link", err, codes.FailedPrecondition)
+	_, err = s.GetOriginalURL(as(stranger), &mainpb.GetOriginalURLRequest{ShortId: "old"})
+	wantCode(t, "GetOriginalURL of someone else's expired link", err, codes.NotFound)
+	_, err = s.UpdateURL(as(stranger), &mainpb.UpdateURLRequest{ShortId: "old", ClearExpiry: true})
+	wantCode(t, "UpdateURL of someone else's expired link", err, codes.NotFound)
+
+	_, err = s.GetURLStats(as(owner), &mainpb.GetURLStatsRequest{ShortId: "old"})
+	wantCode(t, "GetURLStats of an expired link", err, codes.FailedPrecondition)
+	_, err = s.GetURLStats(as(stranger), &mainpb.GetURLStatsRequest{ShortId: "old"})
+	wantCode(t, "GetURLStats of someone else's expired link", err, codes.NotFound)
+
+	if _, err := s.UpdateURL(as(owner), &mainpb.UpdateURLRequest{ShortId: "old", NewExpireInSeconds: 3600}); err != nil {
+		t.Fatalf("UpdateURL extending an expired link = %v", err)
+	}
+	if _, err := s.GetOriginalURL(as(owner), &mainpb.GetOriginalURLRequest{ShortId: "old"}); err != nil {
+		t.Errorf("GetOriginalURL after extending = %v", err)
+	}
+	if stats, err := s.GetURLStats(as(owner), &mainpb.GetURLStatsRequest{ShortId: "old"}); err != nil || stats.ExpireAt == 0 {
+		t.Errorf("GetURLStats after extending = %v, %v, want the new expiry", stats, err)
+	}
+}
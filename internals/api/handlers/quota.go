@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errQuotasUnsupported = status.Error(codes.Unimplemented, "quotas are not configured on this server")
+
+// reserveQuota counts a link about to be created by ownerID against its
+// quotas. Links created without authentication have no owner and no quota.
+func (s *Server) reserveQuota(ctx context.Context, ownerID string) (func(context.Context), error) {
+	if s.Quota == nil || ownerID == "" {
+		return func(context.Context) {}, nil
+	}
+	return s.Quota.Reserve(ctx, ownerID)
+}
+
+// checkActiveQuota checks ownerID may hold one more active link, for
+// expired links being brought back.
+func (s *Server) checkActiveQuota(ctx context.Context, ownerID string) error {
+	if s.Quota == nil || ownerID == "" {
+		return nil
+	}
+	return s.Quota.CheckActive(ctx, ownerID)
+}
+
+// GetQuota reports the link quotas of the caller, or of another owner for
+// admins, with current usage.
+func (s *Server) GetQuota(ctx context.Context, req *mainpb.GetQuotaRequest) (*mainpb.GetQuotaResponse, error) {
+	if s.Quota == nil {
+		return nil, errQuotasUnsupported
+	}
+
+	owner := callerID(ctx)
+	if req.OwnerId != "" && req.OwnerId != owner {
+		if p, ok := auth.FromContext(ctx); ok && !p.Allows(auth.ScopeAdmin) {
+			return nil, status.Error(codes.PermissionDenied, "admin scope required to read another owner's quota")
+		}
+		owner = req.OwnerId
+	}
+	if owner == "" {
+		return nil, status.Error(codes.Unauthenticated, "GetQuota requires an authenticated caller or owner_id")
+	}
+
+	usage, err := s.Quota.Usage(ctx, owner)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to get quota of "+owner)
+	}
+
+	return &mainpb.GetQuotaResponse{
+		OwnerId:              usage.OwnerID,
+		MaxActiveLinks:       usage.MaxActiveLinks,
+		ActiveLinks:          usage.ActiveLinks,
+		RemainingActiveLinks: usage.RemainingActiveLinks(),
+		MaxDailyLinks:        usage.MaxDailyLinks,
+		DailyLinks:           usage.DailyLinks,
+		RemainingDailyLinks:  usage.RemainingDailyLinks(),
+		DailyResetAt:         usage.DailyResetAt.Unix(),
+	}, nil
+}
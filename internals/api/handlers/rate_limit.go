@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/ratelimit"
+)
+
+// RateLimitMiddleware limits requests to next per client IP, answering 429
+// with a Retry-After header once a client's bucket is empty. Requests are
+// let through if the limiter fails.
+func RateLimitMiddleware(limiter ratelimit.Limiter, limit ratelimit.Limit, trustProxy bool, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := "redirect|ip:" + ratelimit.ClientIP(r, trustProxy)
+		allowed, retryAfter, err := limiter.Allow(r.Context(), key, limit)
+		switch {
+		case err != nil:
+			log.Printf("rate limiter unavailable, allowing redirect: %v", err)
+		case !allowed:
+			w.Header().Set("Retry-After", strconv.Itoa(max(1, ratelimit.RetryAfterSeconds(retryAfter))))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/ratelimit"
+)
+
+// stubLimiter answers every call with the same result and records the keys
+// it was asked about.
+type stubLimiter struct {
+	allowed    bool
+	retryAfter time.Duration
+	err        error
+	keys       []string
+}
+
+func (l *stubLimiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (bool, time.Duration, error) {
+	l.keys = append(l.keys, key)
+	return l.allowed, l.retryAfter, l.err
+}
+
+func okRedirect(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "https://example.com", http.StatusFound)
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		limiter    *stubLimiter
+		wantCode   int
+		retryAfter string
+	}{
+		{"allowed", &stubLimiter{allowed: true}, http.StatusFound, ""},
+		{"limited", &stubLimiter{retryAfter: 2500 * time.Millisecond}, http.StatusTooManyRequests, "3"},
+		{"limited without a wait", &stubLimiter{}, http.StatusTooManyRequests, "1"},
+		{"limiter down", &stubLimiter{err: errors.New("redis unreachable")}, http.StatusFound, ""},
+	}
+	for _, tt := range tests {
+		h := RateLimitMiddleware(tt.limiter, ratelimit.Limit{Rate: 1, Burst: 1}, false, okRedirect)
+		rec := httptest.NewRecorder()
+		h(rec, httptest.NewRequest(http.MethodGet, "/abc", nil))
+
+		if rec.Code != tt.wantCode {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.wantCode)
+		}
+		if got := rec.Header().Get("Retry-After"); got != tt.retryAfter {
+			t.Errorf("%s: Retry-After = %q, want %q", tt.name, got, tt.retryAfter)
+		}
+	}
+}
+
+func TestRateLimitMiddlewareKeysByClientIP(t *testing.T) {
+	for _, tt := range []struct {
+		trustProxy bool
+		want       string
+	}{
+		{false, "redirect|ip:10.0.0.2"},
+		{true, "redirect|ip:203.0.113.2"},
+	} {
+		limiter := &stubLimiter{allowed: true}
+		h := RateLimitMiddleware(limiter, ratelimit.Limit{Rate: 1, Burst: 1}, tt.trustProxy, okRedirect)
+
+		// only the last X-Forwarded-For entry, appended by the proxy, counts
+		r := httptest.NewRequest(http.MethodGet, "/abc", nil)
+		r.RemoteAddr = "10.0.0.2:40000"
+		r.Header.Add("X-Forwarded-For", "spoofed, 203.0.113.2")
+		h(httptest.NewRecorder(), r)
+
+		if len(limiter.keys) != 1 || limiter.keys[0] != tt.want {
+			t.Errorf("trustProxy=%v: keys = %q, want [%q]", tt.trustProxy, limiter.keys, tt.want)
+		}
+	}
+
+	// with a real limiter, clients behind a trusted proxy get their own buckets
+	h := RateLimitMiddleware(ratelimit.NewMemory(), ratelimit.Limit{Rate: 0.001, Burst: 1}, true, okRedirect)
+	for _, tt := range []struct {
+		client   string
+		wantCode int
+	}{
+		{"203.0.113.1", http.StatusFound},
+		{"203.0.113.1", http.StatusTooManyRequests},
+		{"203.0.113.2", http.StatusFound},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/abc", nil)
+		r.Header.Set("X-Forwarded-For", tt.client)
+		rec := httptest.NewRecorder()
+		h(rec, r)
+		if rec.Code != tt.wantCode {
+			t.Errorf("client %s: status = %d, want %d", tt.client, rec.Code, tt.wantCode)
+		}
+	}
+}
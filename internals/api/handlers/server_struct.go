@@ -1,11 +1,45 @@
 package handlers
 
 import (
+	"context"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/idgen"
+	"github.com/aayushxrj/aws-url-shortner/internals/quota"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/screening"
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
 	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
-	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
 )
 
 type Server struct {
 	mainpb.UnimplementedUrlShortenerServer
-	DB *db.DynamoClient
-}
\ No newline at end of file
+	Store repository.URLStore
+	// Keys holds API keys; the key RPCs are unimplemented when nil.
+	Keys repository.APIKeyStore
+	// Workspaces holds workspaces and members; workspace RPCs and
+	// workspace links are unavailable when nil.
+	Workspaces repository.WorkspaceStore
+	// Domains holds custom domains; links can only be created on the
+	// primary domain when nil.
+	Domains repository.DomainStore
+	// LookupTXT resolves DNS TXT records to verify domains; the default
+	// resolver when nil.
+	LookupTXT func(ctx context.Context, name string) ([]string, error)
+
+	// IDGen picks candidate short ids; crypto-random base62 when nil.
+	IDGen idgen.IDGenerator
+	// IDLength is the starting short id length; 6 when zero.
+	IDLength int
+
+	// Links builds the short_url returned by ShortenURL; links are served
+	// at http://localhost:8080/<id> when nil.
+	Links *PublicLinks
+
+	// URLValidator checks destinations passed to ShortenURL and UpdateURL.
+	URLValidator *validation.URLValidator
+	// Checker screens destinations for abuse; no screening when nil.
+	Checker screening.DestinationChecker
+	// Quota caps the links each owner may hold and create per day;
+	// unlimited, and GetQuota unimplemented, when nil.
+	Quota *quota.Enforcer
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/idgen"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+const (
+	// defaultShortIDLength is used when Server.IDLength is not set.
+	defaultShortIDLength = 6
+	// maxLengthEscalation caps how many characters allocation may add to
+	// the configured length.
+	maxLengthEscalation = 4
+	// attemptsPerLength is how many collisions are tolerated at one length
+	// before moving to a longer id.
+	attemptsPerLength = 3
+)
+
+// errShortIDExhausted is returned when no free short id could be found
+// within the retry budget.
+var errShortIDExhausted = errors.New("could not allocate a unique short id")
+
+func (s *Server) idGenerator() idgen.IDGenerator {
+	if s.IDGen != nil {
+		return s.IDGen
+	}
+	return idgen.NewRandom(idgen.Base62)
+}
+
+// allocateShortID stores url under a freshly generated short id on domain,
+// "" for the primary domain. The store rejects ids that are already taken,
+// in which case a new id is drawn; repeated collisions at one length suggest
+// a crowded keyspace, so the id grows by one character, up to
+// maxLengthEscalation extra characters.
+func (s *Server) allocateShortID(ctx context.Context, url *models.URL, domain string) error {
+	gen := s.idGenerator()
+	base := s.IDLength
+	if base <= 0 {
+		base = defaultShortIDLength
+	}
+
+	attempt := 0
+	for length := base; length <= base+maxLengthEscalation; length++ {
+		for i := 0; i < attemptsPerLength; i++ {
+			id, err := gen.Generate(ctx, url.OriginalURL, length, attempt)
+			if err != nil {
+				return err
+			}
+			attempt++
+
+			url.ShortID = models.JoinShortID(domain, id)
+			err = s.Store.Create(ctx, url)
+			if !errors.Is(err, repository.ErrAlreadyExists) {
+				return err
+			}
+		}
+	}
+	return errShortIDExhausted
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+)
+
+// repeatGenerator returns "a" repeated to the requested length and records
+// the lengths asked for.
+type repeatGenerator struct{ lengths []int }
+
+func (g *repeatGenerator) Generate(ctx context.Context, originalURL string, length, attempt int) (string, error) {
+	g.lengths = append(g.lengths, length)
+	return strings.Repeat("a", length), nil
+}
+
+func TestAllocateShortIDGrowsOnCollisions(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	for _, id := range []string{"aaaa", "aaaaa"} {
+		if err := store.Create(ctx, &models.URL{ShortID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gen := &repeatGenerator{}
+	s := &Server{Store: store, IDGen: gen, IDLength: 4}
+
+	url := &models.URL{OriginalURL: "https://example.com"}
+	if err := s.allocateShortID(ctx, url, ""); err != nil {
+		t.Fatal(err)
+	}
+	if url.ShortID != "aaaaaa" {
+		t.Errorf("ShortID = %q, want aaaaaa", url.ShortID)
+	}
+	want := []int{4, 4, 4, 5, 5, 5, 6}
+	if !slices.Equal(gen.lengths, want) {
+		t.Errorf("lengths tried = %v, want %v", gen.lengths, want)
+	}
+}
+
+func TestAllocateShortIDGivesUp(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	for n := 2; n <= 2+maxLengthEscalation; n++ {
+		if err := store.Create(ctx, &models.URL{ShortID: strings.Repeat("a", n)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := &Server{Store: store, IDGen: &repeatGenerator{}, IDLength: 2}
+
+	err := s.allocateShortID(ctx, &models.URL{}, "")
+	if !errors.Is(err, errShortIDExhausted) {
+		t.Errorf("allocateShortID = %v, want errShortIDExhausted", err)
+	}
+}
+
+func TestAllocateShortIDOnCustomDomain(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	// The same id on the primary domain does not collide.
+	if err := store.Create(ctx, &models.URL{ShortID: "aaa"}); err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{Store: store, IDGen: &repeatGenerator{}, IDLength: 3}
+
+	url := &models.URL{}
+	if err := s.allocateShortID(ctx, url, "go.acme.com"); err != nil {
+		t.Fatal(err)
+	}
+	if want := models.JoinShortID("go.acme.com", "aaa"); url.ShortID != want {
+		t.Errorf("ShortID = %q, want %q", url.ShortID, want)
+	}
+}
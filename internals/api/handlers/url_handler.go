@@ -2,208 +2,265 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/rand"
+	"log"
 	"time"
 
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
 	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
-	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// random short id generator
-func generateShortID(length int) string {
-	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[rand.Intn(len(charset))]
+// toUrlItem converts a stored URL into its protobuf representation
+func toUrlItem(u *models.URL) *mainpb.UrlItem {
+	return &mainpb.UrlItem{
+		ShortId:     u.ShortID,
+		OriginalUrl: u.OriginalURL,
+		CreatedAt:   u.CreatedAt.Format(time.RFC3339),
+		ExpireAt:    u.ExpireAt,
+		Clicks:      u.Clicks,
+		Flagged:     u.Flagged,
+		OwnerId:     u.OwnerID,
+		WorkspaceId: u.WorkspaceID,
+		Domain:      linkDomainOf(u),
 	}
-	return string(b)
+}
+
+// linkDomainOf returns the custom domain u is bound to, "" for the primary
+// domain.
+func linkDomainOf(u *models.URL) string {
+	domain, _ := models.SplitShortID(u.ShortID)
+	return domain
+}
+
+func (s *Server) urlValidator() *validation.URLValidator {
+	if s.URLValidator != nil {
+		return s.URLValidator
+	}
+	return &validation.URLValidator{}
+}
+
+// screenDestination runs the destination checker on a validated URL. Blocked
+// destinations are rejected as invalid field; otherwise it reports whether
+// the link should be flagged.
+func (s *Server) screenDestination(ctx context.Context, field, url string) (bool, error) {
+	if s.Checker == nil {
+		return false, nil
+	}
+	verdict, err := s.Checker.Check(ctx, url)
+	if err != nil {
+		return false, err
+	}
+	if verdict.Blocked {
+		return false, utils.InvalidArgument(field, "destination is blocked: "+verdict.Reason)
+	}
+	return verdict.Flagged, nil
 }
 
 // ShortenURL creates a new short URL
 func (s *Server) ShortenURL(ctx context.Context, req *mainpb.ShortenURLRequest) (*mainpb.ShortenURLResponse, error) {
-	shortID := generateShortID(6)
 	now := time.Now()
-	expireAt := now.Add(time.Duration(req.ExpireInSeconds) * time.Second).Unix()
+	expireAt, err := resolveExpiry(now, req.ExpireInSeconds, req.ExpireAt, "expire_in_seconds", "expire_at")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "invalid expiry")
+	}
+
+	originalURL, err := s.urlValidator().Normalize("original_url", req.OriginalUrl)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "invalid original_url")
+	}
 
-	item := map[string]types.AttributeValue{
-		"short_id":     &types.AttributeValueMemberS{Value: shortID},
-		"original_url": &types.AttributeValueMemberS{Value: req.OriginalUrl},
-		"created_at":   &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
-		"expire_at":    &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expireAt)},
-		"clicks":       &types.AttributeValueMemberN{Value: "0"},
+	if err := s.rejectSelfLink(ctx, "original_url", originalURL); err != nil {
+		return nil, utils.ErrorHandler(err, "invalid original_url")
 	}
 
-	_, err := s.DB.DB.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: awsString("Urls"),
-		Item:      item,
-	})
+	flagged, err := s.screenDestination(ctx, "original_url", originalURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert item: %v", err)
+		return nil, utils.ErrorHandler(err, "failed to screen original_url")
 	}
 
-	return &mainpb.ShortenURLResponse{
-		ShortId:   shortID,
-		ShortUrl:  fmt.Sprintf("http://localhost:8080/s/%s", shortID),
-		CreatedAt: now.Format(time.RFC3339),
-		ExpireAt:  expireAt,
-	}, nil
-}
+	if req.WorkspaceId != "" {
+		if err := s.requireRole(ctx, req.WorkspaceId, models.RoleEditor); err != nil {
+			return nil, utils.ErrorHandler(err, "cannot create links in workspace "+req.WorkspaceId)
+		}
+	}
 
-// GetOriginalURL fetches the long URL from short ID
-func (s *Server) GetOriginalURL(ctx context.Context, req *mainpb.GetOriginalURLRequest) (*mainpb.GetOriginalURLResponse, error) {
-	out, err := s.DB.DB.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: awsString("Urls"),
-		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: req.ShortId},
-		},
-	})
+	if req.CustomAlias != "" {
+		if err := validateAlias(req.CustomAlias); err != nil {
+			return nil, utils.ErrorHandler(err, "invalid custom_alias")
+		}
+	}
+
+	domain, err := s.linkDomain(ctx, req.Domain)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get item: %v", err)
+		return nil, utils.ErrorHandler(err, "cannot create links on domain "+req.Domain)
 	}
 
-	if out.Item == nil {
-		return nil, fmt.Errorf("short_id not found")
+	url := &models.URL{
+		OriginalURL: originalURL,
+		CreatedAt:   now,
+		ExpireAt:    expireAt,
+		Flagged:     flagged,
+		OwnerID:     callerID(ctx),
+		WorkspaceID: req.WorkspaceId,
 	}
 
-	var data struct {
-		OriginalUrl string `dynamodbav:"original_url"`
+	release, err := s.reserveQuota(ctx, url.OwnerID)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "cannot create more links")
+	}
+	defer func() {
+		if err != nil {
+			release(context.WithoutCancel(ctx))
+		}
+	}()
+
+	if req.CustomAlias != "" {
+		// Vanity aliases are stored as-is; the store rejects taken ones.
+		url.ShortID = models.JoinShortID(domain, req.CustomAlias)
+		err = s.Store.Create(ctx, url)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("custom_alias %q is unavailable", req.CustomAlias))
+		}
+	} else {
+		err = s.allocateShortID(ctx, url, domain)
+		if errors.Is(err, errShortIDExhausted) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 	}
-	err = attributevalue.UnmarshalMap(out.Item, &data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal item: %v", err)
+		return nil, utils.ErrorHandler(err, "failed to create url")
 	}
 
-	return &mainpb.GetOriginalURLResponse{
-		OriginalUrl: data.OriginalUrl,
+	return &mainpb.ShortenURLResponse{
+		ShortId:     url.ShortID,
+		ShortUrl:    s.links().URL(url.ShortID),
+		CreatedAt:   now.Format(time.RFC3339),
+		ExpireAt:    url.ExpireAt,
+		Flagged:     url.Flagged,
+		WorkspaceId: url.WorkspaceID,
+		Domain:      domain,
 	}, nil
 }
 
-// IncrementClick increases click counter
-func (s *Server) IncrementClick(ctx context.Context, req *mainpb.IncrementClickRequest) (*mainpb.IncrementClickResponse, error) {
-	out, err := s.DB.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: awsString("Urls"),
-		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: req.ShortId},
-		},
-		UpdateExpression: awsString("SET clicks = clicks + :incr"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":incr": &types.AttributeValueMemberN{Value: "1"},
-		},
-		ReturnValues: types.ReturnValueUpdatedNew,
-	})
+// GetOriginalURL fetches the long URL from short ID
+func (s *Server) GetOriginalURL(ctx context.Context, req *mainpb.GetOriginalURLRequest) (*mainpb.GetOriginalURLResponse, error) {
+	url, err := s.authorizeLink(ctx, req.ShortId, models.RoleViewer)
+	if err == nil && url.Expired(time.Now()) {
+		err = repository.ErrExpired
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to update click count: %v", err)
+		return nil, utils.ErrorHandler(err, "failed to get url "+req.ShortId)
 	}
 
-	var data struct {
-		Clicks int64 `dynamodbav:"clicks"`
+	return &mainpb.GetOriginalURLResponse{
+		OriginalUrl: url.OriginalURL,
+	}, nil
+}
+
+// IncrementClick increases click counter
+func (s *Server) IncrementClick(ctx context.Context, req *mainpb.IncrementClickRequest) (*mainpb.IncrementClickResponse, error) {
+	if _, err := s.authorizeLink(ctx, req.ShortId, models.RoleEditor); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to update click count for "+req.ShortId)
 	}
-	err = attributevalue.UnmarshalMap(out.Attributes, &data)
+	clicks, err := s.Store.IncrementClicks(ctx, req.ShortId, 1)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse updated data: %v", err)
+		return nil, utils.ErrorHandler(err, "failed to update click count for "+req.ShortId)
 	}
 
 	return &mainpb.IncrementClickResponse{
-		Clicks: data.Clicks,
+		Clicks: clicks,
 	}, nil
 }
 
-func awsString(v string) *string {
-	return &v
-}
-
-func awsInt32(v int32) *int32 {
-	return &v
-}
-
-// ✅ Health Check RPC — verifies DynamoDB connection
+// ✅ Health Check RPC — verifies the storage backend connection
 func (s *Server) HealthCheck(ctx context.Context, req *mainpb.HealthCheckRequest) (*mainpb.HealthCheckResponse, error) {
-	_, err := s.DB.DB.ListTables(ctx, &dynamodb.ListTablesInput{Limit: awsInt32(1)})
-	if err != nil {
-		return &mainpb.HealthCheckResponse{Status: "unhealthy"}, err
+	if err := s.Store.Ping(ctx); err != nil {
+		log.Printf("health check failed: %v", err)
+		return &mainpb.HealthCheckResponse{Status: "unhealthy"}, status.Error(codes.Unavailable, "storage backend unavailable")
 	}
 	return &mainpb.HealthCheckResponse{Status: "ok"}, nil
 }
 
 // ✅ Get stats for one URL (short_id)
 func (s *Server) GetURLStats(ctx context.Context, req *mainpb.GetURLStatsRequest) (*mainpb.GetURLStatsResponse, error) {
-	tableName := "Urls"
-
-	out, err := s.DB.DB.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: &tableName,
-		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: req.ShortId},
-		},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get item: %v", err)
+	url, err := s.authorizeLink(ctx, req.ShortId, models.RoleViewer)
+	if err == nil && url.Expired(time.Now()) {
+		err = repository.ErrExpired
 	}
-
-	if out.Item == nil {
-		return nil, fmt.Errorf("short_id %s not found", req.ShortId)
-	}
-
-	var item struct {
-		ShortID     string `dynamodbav:"short_id"`
-		OriginalURL string `dynamodbav:"original_url"`
-		CreatedAt   string `dynamodbav:"created_at"`
-		ExpireAt    int64  `dynamodbav:"expire_at"`
-		Clicks      int64  `dynamodbav:"clicks"`
-	}
-
-	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal item: %v", err)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to get stats for "+req.ShortId)
 	}
 
 	return &mainpb.GetURLStatsResponse{
-		ShortId:     item.ShortID,
-		OriginalUrl: item.OriginalURL,
-		Clicks:      item.Clicks,
-		CreatedAt:   item.CreatedAt,
-		ExpireAt:    item.ExpireAt,
+		ShortId:     url.ShortID,
+		OriginalUrl: url.OriginalURL,
+		Clicks:      url.Clicks,
+		CreatedAt:   url.CreatedAt.Format(time.RFC3339),
+		ExpireAt:    url.ExpireAt,
+		Flagged:     url.Flagged,
+		OwnerId:     url.OwnerID,
+		WorkspaceId: url.WorkspaceID,
+		Domain:      linkDomainOf(url),
 	}, nil
 }
 
 // ✅ Update existing URL (destination or expiry)
 func (s *Server) UpdateURL(ctx context.Context, req *mainpb.UpdateURLRequest) (*mainpb.UpdateURLResponse, error) {
-	tableName := "Urls"
+	url, err := s.authorizeLink(ctx, req.ShortId, models.RoleEditor)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to update url "+req.ShortId)
+	}
 
-	expr := "SET"
-	attrs := map[string]types.AttributeValue{}
-	exprNames := map[string]string{}
+	var upd models.URLUpdate
 
 	if req.NewOriginalUrl != "" {
-		expr += " #url = :u"
-		exprNames["#url"] = "original_url"
-		attrs[":u"] = &types.AttributeValueMemberS{Value: req.NewOriginalUrl}
+		originalURL, err := s.urlValidator().Normalize("new_original_url", req.NewOriginalUrl)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "invalid new_original_url")
+		}
+		if err := s.rejectSelfLink(ctx, "new_original_url", originalURL); err != nil {
+			return nil, utils.ErrorHandler(err, "invalid new_original_url")
+		}
+		flagged, err := s.screenDestination(ctx, "new_original_url", originalURL)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "failed to screen new_original_url")
+		}
+		upd.OriginalURL = &originalURL
+		upd.Flagged = &flagged
 	}
 
-	if req.NewExpireInSeconds > 0 {
-		if len(attrs) > 0 {
-			expr += ","
+	if req.ClearExpiry {
+		if req.NewExpireInSeconds != 0 || req.NewExpireAt != 0 {
+			return nil, utils.ErrorHandler(utils.InvalidArgument("clear_expiry", "cannot be combined with a new expiry"), "invalid expiry")
 		}
-		expireAt := time.Now().Add(time.Duration(req.NewExpireInSeconds) * time.Second).Unix()
-		expr += " #exp = :e"
-		exprNames["#exp"] = "expire_at"
-		attrs[":e"] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expireAt)}
+		never := int64(0)
+		upd.ExpireAt = &never
+	} else if req.NewExpireInSeconds != 0 || req.NewExpireAt != 0 {
+		expireAt, err := resolveExpiry(time.Now(), req.NewExpireInSeconds, req.NewExpireAt, "new_expire_in_seconds", "new_expire_at")
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "invalid expiry")
+		}
+		upd.ExpireAt = &expireAt
 	}
 
-	if len(attrs) == 0 {
+	if upd.OriginalURL == nil && upd.ExpireAt == nil {
 		return &mainpb.UpdateURLResponse{Success: false, Message: "No update fields provided"}, nil
 	}
+	// a new expiry brings an expired link back, which takes active quota
+	if upd.ExpireAt != nil && url.Expired(time.Now()) {
+		if err := s.checkActiveQuota(ctx, url.OwnerID); err != nil {
+			return nil, utils.ErrorHandler(err, "failed to update url "+req.ShortId)
+		}
+	}
 
-	_, err := s.DB.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName:                 &tableName,
-		Key:                       map[string]types.AttributeValue{"short_id": &types.AttributeValueMemberS{Value: req.ShortId}},
-		UpdateExpression:          &expr,
-		ExpressionAttributeNames:  exprNames,
-		ExpressionAttributeValues: attrs,
-	})
-	if err != nil {
-		return &mainpb.UpdateURLResponse{Success: false, Message: err.Error()}, err
+	if err := s.Store.Update(ctx, req.ShortId, upd); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to update url "+req.ShortId)
 	}
 
 	return &mainpb.UpdateURLResponse{Success: true, Message: "URL updated successfully"}, nil
@@ -211,16 +268,11 @@ func (s *Server) UpdateURL(ctx context.Context, req *mainpb.UpdateURLRequest) (*
 
 // ✅ Delete short URL
 func (s *Server) DeleteURL(ctx context.Context, req *mainpb.DeleteURLRequest) (*mainpb.DeleteURLResponse, error) {
-	tableName := "Urls"
-
-	_, err := s.DB.DB.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: &tableName,
-		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: req.ShortId},
-		},
-	})
-	if err != nil {
-		return &mainpb.DeleteURLResponse{Success: false, Message: err.Error()}, err
+	if _, err := s.authorizeLink(ctx, req.ShortId, models.RoleEditor); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to delete url "+req.ShortId)
+	}
+	if err := s.Store.Delete(ctx, req.ShortId); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to delete url "+req.ShortId)
 	}
 
 	return &mainpb.DeleteURLResponse{Success: true, Message: "URL deleted successfully"}, nil
@@ -228,54 +280,53 @@ func (s *Server) DeleteURL(ctx context.Context, req *mainpb.DeleteURLRequest) (*
 
 // ✅ List all shortened URLs
 func (s *Server) ListAllURLs(ctx context.Context, req *mainpb.ListAllURLsRequest) (*mainpb.ListAllURLsResponse, error) {
-	tableName := "Urls"
-
-	input := &dynamodb.ScanInput{
-		TableName: &tableName,
-		Limit:     awsInt32(int32(req.Limit)),
+	urls, lastKey, err := s.Store.List(ctx, req.Limit, req.LastEvaluatedKey)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to list urls")
 	}
 
-	if req.LastEvaluatedKey != "" {
-		input.ExclusiveStartKey = map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: req.LastEvaluatedKey},
-		}
+	pbUrls := make([]*mainpb.UrlItem, 0, len(urls))
+	for _, u := range urls {
+		pbUrls = append(pbUrls, toUrlItem(u))
 	}
 
-	out, err := s.DB.DB.Scan(ctx, input)
-	if err != nil {
-		return nil, fmt.Errorf("failed to scan table: %v", err)
-	}
+	return &mainpb.ListAllURLsResponse{
+		Urls:             pbUrls,
+		LastEvaluatedKey: lastKey,
+	}, nil
+}
 
-	var urls []struct {
-		ShortID     string `dynamodbav:"short_id"`
-		OriginalURL string `dynamodbav:"original_url"`
-		CreatedAt   string `dynamodbav:"created_at"`
-		ExpireAt    int64  `dynamodbav:"expire_at"`
-		Clicks      int64  `dynamodbav:"clicks"`
+// ListMyURLs lists the links owned by the caller, or the links of one of
+// the caller's workspaces
+func (s *Server) ListMyURLs(ctx context.Context, req *mainpb.ListMyURLsRequest) (*mainpb.ListMyURLsResponse, error) {
+	var (
+		urls    []*models.URL
+		lastKey string
+		err     error
+	)
+	if req.WorkspaceId != "" {
+		if err := s.requireRole(ctx, req.WorkspaceId, models.RoleViewer); err != nil {
+			return nil, utils.ErrorHandler(err, "cannot list workspace "+req.WorkspaceId)
+		}
+		urls, lastKey, err = s.Store.ListByWorkspace(ctx, req.WorkspaceId, req.Limit, req.LastEvaluatedKey)
+	} else {
+		owner := callerID(ctx)
+		if owner == "" {
+			return nil, status.Error(codes.Unauthenticated, "ListMyURLs requires an authenticated caller")
+		}
+		urls, lastKey, err = s.Store.ListByOwner(ctx, owner, req.Limit, req.LastEvaluatedKey)
 	}
-
-	if err := attributevalue.UnmarshalListOfMaps(out.Items, &urls); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal results: %v", err)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to list urls")
 	}
 
 	pbUrls := make([]*mainpb.UrlItem, 0, len(urls))
 	for _, u := range urls {
-		pbUrls = append(pbUrls, &mainpb.UrlItem{
-			ShortId:     u.ShortID,
-			OriginalUrl: u.OriginalURL,
-			CreatedAt:   u.CreatedAt,
-			ExpireAt:    u.ExpireAt,
-			Clicks:      u.Clicks,
-		})
+		pbUrls = append(pbUrls, toUrlItem(u))
 	}
 
-	var lastKey string
-	if val, ok := out.LastEvaluatedKey["short_id"]; ok {
-		lastKey = val.(*types.AttributeValueMemberS).Value
-	}
-
-	return &mainpb.ListAllURLsResponse{
-		Urls:              pbUrls,
-		LastEvaluatedKey:  lastKey,
+	return &mainpb.ListMyURLsResponse{
+		Urls:             pbUrls,
+		LastEvaluatedKey: lastKey,
 	}, nil
 }
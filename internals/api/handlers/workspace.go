@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errWorkspacesUnsupported = status.Error(codes.Unimplemented, "workspaces are not supported by this storage backend")
+
+// workspaceRole returns the caller's role in workspaceID. Callers that see
+// all links act as admins of every workspace. Non-members get
+// ErrWorkspaceNotFound so that workspace ids cannot be probed.
+func (s *Server) workspaceRole(ctx context.Context, workspaceID string) (models.Role, error) {
+	if s.Workspaces == nil {
+		return "", errWorkspacesUnsupported
+	}
+	if seesAllLinks(ctx) {
+		if _, err := s.Workspaces.GetWorkspace(ctx, workspaceID); err != nil {
+			return "", err
+		}
+		return models.RoleAdmin, nil
+	}
+
+	member, err := s.Workspaces.GetMember(ctx, workspaceID, callerID(ctx))
+	if errors.Is(err, repository.ErrMemberNotFound) {
+		return "", repository.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// requireRole checks that the caller holds at least min in workspaceID.
+func (s *Server) requireRole(ctx context.Context, workspaceID string, min models.Role) error {
+	role, err := s.workspaceRole(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if !role.Allows(min) {
+		return status.Errorf(codes.PermissionDenied, "%s role required in workspace %s", min, workspaceID)
+	}
+	return nil
+}
+
+// CreateWorkspace creates a workspace with the caller as its admin
+func (s *Server) CreateWorkspace(ctx context.Context, req *mainpb.CreateWorkspaceRequest) (*mainpb.CreateWorkspaceResponse, error) {
+	if s.Workspaces == nil {
+		return nil, errWorkspacesUnsupported
+	}
+	creator := callerID(ctx)
+	if creator == "" {
+		return nil, status.Error(codes.Unauthenticated, "CreateWorkspace requires an authenticated caller")
+	}
+
+	now := time.Now()
+	ws := &models.Workspace{Name: req.Name, CreatedAt: now, CreatedBy: creator}
+	var err error
+	for range keyIDAttempts {
+		ws.ID = rand.Text()[:12]
+		err = s.Workspaces.CreateWorkspace(ctx, ws, &models.Member{
+			WorkspaceID: ws.ID,
+			UserID:      creator,
+			Role:        models.RoleAdmin,
+			AddedAt:     now,
+		})
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to create workspace")
+	}
+
+	return &mainpb.CreateWorkspaceResponse{
+		WorkspaceId: ws.ID,
+		Name:        ws.Name,
+		CreatedAt:   now.Format(time.RFC3339),
+	}, nil
+}
+
+// InviteMember adds a member to a workspace, or changes an existing
+// member's role
+func (s *Server) InviteMember(ctx context.Context, req *mainpb.InviteMemberRequest) (*mainpb.InviteMemberResponse, error) {
+	role, err := models.ParseRole(req.Role)
+	if err != nil {
+		return nil, utils.ErrorHandler(utils.InvalidArgument("role", err.Error()), "invalid role")
+	}
+	if err := s.requireRole(ctx, req.WorkspaceId, models.RoleAdmin); err != nil {
+		return nil, utils.ErrorHandler(err, "cannot manage workspace "+req.WorkspaceId)
+	}
+
+	err = s.Workspaces.PutMember(ctx, &models.Member{
+		WorkspaceID: req.WorkspaceId,
+		UserID:      req.UserId,
+		Role:        role,
+		AddedAt:     time.Now(),
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to add member to workspace "+req.WorkspaceId)
+	}
+
+	return &mainpb.InviteMemberResponse{Success: true, Message: "Member added as " + string(role)}, nil
+}
+
+// RemoveMember removes a member from a workspace
+func (s *Server) RemoveMember(ctx context.Context, req *mainpb.RemoveMemberRequest) (*mainpb.RemoveMemberResponse, error) {
+	if err := s.requireRole(ctx, req.WorkspaceId, models.RoleAdmin); err != nil {
+		return nil, utils.ErrorHandler(err, "cannot manage workspace "+req.WorkspaceId)
+	}
+	if err := s.Workspaces.DeleteMember(ctx, req.WorkspaceId, req.UserId); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to remove member from workspace "+req.WorkspaceId)
+	}
+
+	return &mainpb.RemoveMemberResponse{Success: true, Message: "Member removed successfully"}, nil
+}
+
+// TransferURL moves a link into another workspace, or back to its owner's
+// personal links. Moving a link out of a workspace takes the admin role
+// there, moving it in takes the editor role in the target.
+func (s *Server) TransferURL(ctx context.Context, req *mainpb.TransferURLRequest) (*mainpb.TransferURLResponse, error) {
+	url, err := s.authorizeLink(ctx, req.ShortId, models.RoleAdmin)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to transfer url "+req.ShortId)
+	}
+
+	if req.WorkspaceId == url.WorkspaceID {
+		return &mainpb.TransferURLResponse{Success: false, Message: "URL is already there"}, nil
+	}
+	if req.WorkspaceId != "" {
+		if err := s.requireRole(ctx, req.WorkspaceId, models.RoleEditor); err != nil {
+			return nil, utils.ErrorHandler(err, "cannot move links into workspace "+req.WorkspaceId)
+		}
+	}
+
+	if err := s.Store.Update(ctx, req.ShortId, models.URLUpdate{WorkspaceID: &req.WorkspaceId}); err != nil {
+		return nil, utils.ErrorHandler(err, "failed to transfer url "+req.ShortId)
+	}
+
+	return &mainpb.TransferURLResponse{Success: true, Message: "URL transferred successfully"}, nil
+}
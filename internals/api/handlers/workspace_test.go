@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateWorkspaceMakesTheCallerAdmin(t *testing.T) {
+	s := newTestServer(t)
+
+	resp, err := s.CreateWorkspace(as(stranger), &mainpb.CreateWorkspaceRequest{Name: "launch"})
+	if err != nil {
+		t.Fatalf("CreateWorkspace = %v", err)
+	}
+	ws, err := s.Workspaces.GetWorkspace(context.Background(), resp.WorkspaceId)
+	if err != nil || ws.Name != "launch" || ws.CreatedBy != stranger.Subject {
+		t.Errorf("stored workspace = %+v, %v", ws, err)
+	}
+	m, err := s.Workspaces.GetMember(context.Background(), resp.WorkspaceId, stranger.Subject)
+	if err != nil || m.Role != models.RoleAdmin {
+		t.Errorf("creator membership = %+v, %v, want admin", m, err)
+	}
+
+	_, err = s.CreateWorkspace(context.Background(), &mainpb.CreateWorkspaceRequest{Name: "anonymous"})
+	wantCode(t, "CreateWorkspace without a caller", err, codes.Unauthenticated)
+}
+
+func TestWorkspaceMembersAreManagedByAdmins(t *testing.T) {
+	s := newTestServer(t)
+	invite := func(ctx context.Context, role string) error {
+		_, err := s.InviteMember(ctx, &mainpb.InviteMemberRequest{WorkspaceId: "TEAM", UserId: stranger.Subject, Role: role})
+		return err
+	}
+	role := func() models.Role {
+		m, err := s.Workspaces.GetMember(context.Background(), "TEAM", stranger.Subject)
+		if err != nil {
+			return ""
+		}
+		return m.Role
+	}
+
+	wantCode(t, "InviteMember by a viewer", invite(as(viewer), "editor"), codes.PermissionDenied)
+	wantCode(t, "InviteMember by a non-member", invite(as(stranger), "admin"), codes.NotFound)
+	wantCode(t, "InviteMember with an unknown role", invite(as(owner), "owner"), codes.InvalidArgument)
+
+	wantCode(t, "InviteMember by the workspace admin", invite(as(owner), "editor"), codes.OK)
+	if got := role(); got != models.RoleEditor {
+		t.Errorf("role after the invite = %q, want editor", got)
+	}
+	wantCode(t, "role change by the workspace admin", invite(as(owner), "viewer"), codes.OK)
+	if got := role(); got != models.RoleViewer {
+		t.Errorf("role after the change = %q, want viewer", got)
+	}
+	wantCode(t, "role change by an admin", invite(as(admin), "editor"), codes.OK)
+
+	remove := func(ctx context.Context) error {
+		_, err := s.RemoveMember(ctx, &mainpb.RemoveMemberRequest{WorkspaceId: "TEAM", UserId: stranger.Subject})
+		return err
+	}
+	wantCode(t, "RemoveMember by a viewer", remove(as(viewer)), codes.PermissionDenied)
+	wantCode(t, "RemoveMember by the workspace admin", remove(as(owner)), codes.OK)
+	if _, err := s.Workspaces.GetMember(context.Background(), "TEAM", stranger.Subject); !errors.Is(err, repository.ErrMemberNotFound) {
+		t.Errorf("membership after removal = %v, want ErrMemberNotFound", err)
+	}
+}
+
+func TestTransferURL(t *testing.T) {
+	s := newTestServer(t)
+	if err := s.Workspaces.PutMember(context.Background(), &models.Member{WorkspaceID: "TEAM", UserID: stranger.Subject, Role: models.RoleEditor}); err != nil {
+		t.Fatal(err)
+	}
+	transfer := func(ctx context.Context, id, workspace string) error {
+		_, err := s.TransferURL(ctx, &mainpb.TransferURLRequest{ShortId: id, WorkspaceId: workspace})
+		return err
+	}
+	workspaceOf := func(id string) string {
+		u, err := s.Store.Get(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u.WorkspaceID
+	}
+
+	wantCode(t, "personal link into a workspace", transfer(as(owner), "mine", "TEAM"), codes.OK)
+	if got := workspaceOf("mine"); got != "TEAM" {
+		t.Errorf("workspace after moving in = %q, want TEAM", got)
+	}
+	if _, err := s.GetURLStats(as(viewer), &mainpb.GetURLStatsRequest{ShortId: "mine"}); err != nil {
+		t.Errorf("workspace viewer cannot see the moved link: %v", err)
+	}
+
+	wantCode(t, "workspace link out by a viewer", transfer(as(viewer), "mine", ""), codes.PermissionDenied)
+	wantCode(t, "workspace link out by an editor", transfer(as(stranger), "mine", ""), codes.PermissionDenied)
+	wantCode(t, "workspace link out by the workspace admin", transfer(as(owner), "mine", ""), codes.OK)
+	if got := workspaceOf("mine"); got != "" {
+		t.Errorf("workspace after moving out = %q, want a personal link", got)
+	}
+
+	wantCode(t, "someone else's link", transfer(as(stranger), "mine", "TEAM"), codes.NotFound)
+	wantCode(t, "into a workspace the caller is not in", transfer(as(owner), "mine", "NOPE"), codes.NotFound)
+	if got := workspaceOf("mine"); got != "" {
+		t.Errorf("workspace after refused transfers = %q, want a personal link", got)
+	}
+}
@@ -0,0 +1,154 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MethodScopes is the scope each UrlShortener method requires. Methods
+// missing from the table require admin.
+var MethodScopes = map[string]auth.Scope{
+	mainpb.UrlShortener_GetOriginalURL_FullMethodName:  auth.ScopeRead,
+	mainpb.UrlShortener_GetURLStats_FullMethodName:     auth.ScopeRead,
+	mainpb.UrlShortener_ListMyURLs_FullMethodName:      auth.ScopeRead,
+	mainpb.UrlShortener_GetQuota_FullMethodName:        auth.ScopeRead,
+	mainpb.UrlShortener_ShortenURL_FullMethodName:      auth.ScopeWrite,
+	mainpb.UrlShortener_IncrementClick_FullMethodName:  auth.ScopeWrite,
+	mainpb.UrlShortener_UpdateURL_FullMethodName:       auth.ScopeWrite,
+	mainpb.UrlShortener_DeleteURL_FullMethodName:       auth.ScopeWrite,
+	mainpb.UrlShortener_TransferURL_FullMethodName:     auth.ScopeWrite,
+	mainpb.UrlShortener_CreateWorkspace_FullMethodName: auth.ScopeWrite,
+	mainpb.UrlShortener_InviteMember_FullMethodName:    auth.ScopeWrite,
+	mainpb.UrlShortener_RemoveMember_FullMethodName:    auth.ScopeWrite,
+	mainpb.UrlShortener_RegisterDomain_FullMethodName:  auth.ScopeWrite,
+	mainpb.UrlShortener_VerifyDomain_FullMethodName:    auth.ScopeWrite,
+	mainpb.UrlShortener_ListAllURLs_FullMethodName:     auth.ScopeAdmin,
+	mainpb.UrlShortener_CreateAPIKey_FullMethodName:    auth.ScopeAdmin,
+	mainpb.UrlShortener_RevokeAPIKey_FullMethodName:    auth.ScopeAdmin,
+}
+
+// PublicMethods can be called without credentials.
+var PublicMethods = map[string]bool{
+	mainpb.UrlShortener_HealthCheck_FullMethodName: true,
+}
+
+var (
+	errMissingKey = status.Error(codes.Unauthenticated, "missing api key or bearer token")
+	errInvalidKey = status.Error(codes.Unauthenticated, "invalid api key")
+	errRevokedKey = status.Error(codes.Unauthenticated, "api key has been revoked")
+)
+
+// Authenticator checks the credential sent as "authorization: Bearer <token>"
+// metadata, either an API key or a JWT from the identity provider, and the
+// scope the called method requires. The caller is stored in the context as
+// an auth.Principal for the handlers.
+type Authenticator struct {
+	Keys repository.APIKeyStore
+	// JWT verifies bearer tokens that are not API keys; only API keys are
+	// accepted when nil.
+	JWT *auth.JWTVerifier
+	// BootstrapKey, when set, is accepted as an admin key so that the first
+	// stored keys can be created. It is never written to the store.
+	BootstrapKey string
+}
+
+// Unary is the unary server interceptor.
+func (a *Authenticator) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if PublicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	principal, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	required, ok := MethodScopes[info.FullMethod]
+	if !ok {
+		required = auth.ScopeAdmin
+	}
+	if !principal.Allows(required) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s scope required", required)
+	}
+	return handler(auth.NewContext(ctx, principal), req)
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (*auth.Principal, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, errMissingKey
+	}
+
+	if a.BootstrapKey != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.BootstrapKey)) == 1 {
+		return &auth.Principal{Subject: auth.BootstrapSubject, Scopes: []auth.Scope{auth.ScopeAdmin}}, nil
+	}
+
+	id, ok := auth.ParseAPIKey(token)
+	if !ok {
+		if a.JWT == nil {
+			return nil, errInvalidKey
+		}
+		principal, err := a.JWT.Verify(ctx, token)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "failed to verify token")
+		}
+		return principal, nil
+	}
+
+	if a.Keys == nil {
+		return nil, errInvalidKey
+	}
+	key, err := a.Keys.GetAPIKey(ctx, id)
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		return nil, errInvalidKey
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to verify api key")
+	}
+	if !auth.MatchAPIKey(token, key.Hash) {
+		return nil, errInvalidKey
+	}
+	if key.Revoked() {
+		return nil, errRevokedKey
+	}
+
+	principal := &auth.Principal{Subject: auth.KeySubject(key.ID)}
+	for _, name := range key.Scopes {
+		if scope, err := auth.ParseScope(name); err == nil {
+			principal.Scopes = append(principal.Scopes, scope)
+		}
+	}
+	return principal, nil
+}
+
+// bearerToken returns the credential from the authorization metadata, with
+// an optional "Bearer " prefix removed.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	token := strings.TrimSpace(values[0])
+	if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+		token = strings.TrimSpace(token[7:])
+	}
+	return token
+}
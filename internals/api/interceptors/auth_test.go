@@ -0,0 +1,160 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const bootstrapKey = "bootstrap-secret"
+
+// testKeys stores one key per scope, plus a revoked admin key, and returns
+// the plaintext keys by name.
+func testKeys(t *testing.T) (*db.MemoryStore, map[string]string) {
+	t.Helper()
+	store := db.NewMemoryStore()
+	keys := make(map[string]string)
+	for _, name := range []string{"read", "write", "admin", "revoked"} {
+		id, key := auth.NewAPIKey()
+		scope := name
+		if name == "revoked" {
+			scope = "admin"
+		}
+		err := store.CreateAPIKey(context.Background(), &models.APIKey{
+			ID:        id,
+			Name:      name,
+			Hash:      auth.HashAPIKey(key),
+			Scopes:    []string{scope},
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name == "revoked" {
+			if err := store.RevokeAPIKey(context.Background(), id, time.Now().Unix()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		keys[name] = key
+	}
+	return store, keys
+}
+
+func withToken(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+}
+
+func TestAuthenticatorMethodScopes(t *testing.T) {
+	store, keys := testKeys(t)
+	a := &Authenticator{Keys: store}
+
+	scopes := map[string]auth.Scope{
+		"/main.UrlShortener/NotInTheTable": auth.ScopeAdmin,
+	}
+	for method, scope := range MethodScopes {
+		scopes[method] = scope
+	}
+	for method, required := range scopes {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		for _, scope := range []auth.Scope{auth.ScopeRead, auth.ScopeWrite, auth.ScopeAdmin} {
+			_, err := a.Unary(withToken("Bearer "+keys[string(scope)]), nil, info, okHandler)
+			allowed := (&auth.Principal{Scopes: []auth.Scope{scope}}).Allows(required)
+			if allowed && err != nil {
+				t.Errorf("%s with a %s key = %v, want allowed", method, scope, err)
+			}
+			if !allowed && status.Code(err) != codes.PermissionDenied {
+				t.Errorf("%s with a %s key = %v, want PERMISSION_DENIED", method, scope, err)
+			}
+		}
+	}
+}
+
+func TestAuthenticatorPrincipal(t *testing.T) {
+	store, keys := testKeys(t)
+	a := &Authenticator{Keys: store, BootstrapKey: bootstrapKey}
+
+	var got *auth.Principal
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = auth.FromContext(ctx)
+		return "ok", nil
+	}
+
+	id, _ := auth.ParseAPIKey(keys["write"])
+	for _, authorization := range []string{
+		keys["write"],
+		"Bearer " + keys["write"],
+		"bearer   " + keys["write"] + " ",
+	} {
+		got = nil
+		if _, err := a.Unary(withToken(authorization), nil, shortenInfo, handler); err != nil {
+			t.Fatalf("%q: %v", authorization, err)
+		}
+		if got == nil || got.Subject != auth.KeySubject(id) || !got.Allows(auth.ScopeWrite) || got.Allows(auth.ScopeAdmin) {
+			t.Errorf("%q: principal = %+v, want a write key %s", authorization, got, id)
+		}
+	}
+
+	got = nil
+	info := &grpc.UnaryServerInfo{FullMethod: mainpb.UrlShortener_CreateAPIKey_FullMethodName}
+	if _, err := a.Unary(withToken("Bearer "+bootstrapKey), nil, info, handler); err != nil {
+		t.Fatalf("bootstrap key: %v", err)
+	}
+	if got == nil || got.Subject != auth.BootstrapSubject || !got.Allows(auth.ScopeAdmin) {
+		t.Errorf("bootstrap key: principal = %+v, want an admin bootstrap principal", got)
+	}
+}
+
+func TestAuthenticatorRejects(t *testing.T) {
+	store, keys := testKeys(t)
+	a := &Authenticator{Keys: store, BootstrapKey: bootstrapKey}
+
+	id, _ := auth.ParseAPIKey(keys["admin"])
+	_, unknownKey := auth.NewAPIKey()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"no metadata", context.Background(), errMissingKey},
+		{"blank", withToken("  "), errMissingKey},
+		{"revoked", withToken("Bearer " + keys["revoked"]), errRevokedKey},
+		{"wrong secret", withToken("Bearer usk_" + id + "_" + strings.Repeat("A", 26)), errInvalidKey},
+		{"unknown id", withToken("Bearer " + unknownKey), errInvalidKey},
+		{"not a key", withToken("Bearer something-else"), errInvalidKey},
+		{"bootstrap prefix", withToken("Bearer " + bootstrapKey + "x"), errInvalidKey},
+	}
+	for _, tt := range tests {
+		_, err := a.Unary(tt.ctx, nil, shortenInfo, okHandler)
+		if err != tt.want {
+			t.Errorf("%s: %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// once unset, the bootstrap key is just an unknown credential
+	a.BootstrapKey = ""
+	if _, err := a.Unary(withToken("Bearer "+bootstrapKey), nil, shortenInfo, okHandler); err != errInvalidKey {
+		t.Errorf("bootstrap key while disabled = %v, want %v", err, errInvalidKey)
+	}
+}
+
+func TestAuthenticatorPublicMethods(t *testing.T) {
+	a := &Authenticator{}
+	info := &grpc.UnaryServerInfo{FullMethod: mainpb.UrlShortener_HealthCheck_FullMethodName}
+	if _, err := a.Unary(context.Background(), nil, info, okHandler); err != nil {
+		t.Errorf("HealthCheck without credentials = %v", err)
+	}
+	if _, err := a.Unary(context.Background(), nil, shortenInfo, okHandler); err != errMissingKey {
+		t.Errorf("ShortenURL without credentials = %v, want %v", err, errMissingKey)
+	}
+}
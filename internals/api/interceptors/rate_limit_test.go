@@ -0,0 +1,83 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/auth"
+	"github.com/aayushxrj/aws-url-shortner/internals/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fromProxy returns a context for a call relayed by the proxy at 10.0.0.2
+// on behalf of the client forwarded.
+func fromProxy(forwarded string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 40000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwarded))
+}
+
+func TestUnaryByIP(t *testing.T) {
+	for _, trust := range []bool{false, true} {
+		rl := &RateLimiter{
+			Limiter:    ratelimit.NewMemory(),
+			ByIP:       ratelimit.Policy{"*": {Rate: 0.001, Burst: 1}},
+			TrustProxy: trust,
+		}
+		if _, err := rl.UnaryByIP(fromProxy("203.0.113.1"), nil, shortenInfo, okHandler); err != nil {
+			t.Fatalf("trust=%v: first call = %v", trust, err)
+		}
+
+		// a second client behind the same proxy only gets its own bucket
+		// when the forwarded address is trusted
+		_, err := rl.UnaryByIP(fromProxy("spoofed, 203.0.113.2"), nil, shortenInfo, okHandler)
+		if trust && err != nil {
+			t.Errorf("trusted proxy: second client was limited: %v", err)
+		}
+		if !trust && status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("untrusted proxy: second client = %v, want RESOURCE_EXHAUSTED", err)
+		}
+	}
+}
+
+func TestUnaryByPrincipal(t *testing.T) {
+	rl := &RateLimiter{
+		Limiter:     ratelimit.NewMemory(),
+		ByPrincipal: ratelimit.Policy{"ShortenURL": {Rate: 0.001, Burst: 1}},
+	}
+	alice := auth.NewContext(context.Background(), &auth.Principal{Subject: auth.KeySubject("ALICE")})
+	bob := auth.NewContext(context.Background(), &auth.Principal{Subject: auth.KeySubject("BOB")})
+
+	if _, err := rl.UnaryByPrincipal(alice, nil, shortenInfo, okHandler); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rl.UnaryByPrincipal(alice, nil, shortenInfo, okHandler); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second call = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if _, err := rl.UnaryByPrincipal(bob, nil, shortenInfo, okHandler); err != nil {
+		t.Errorf("another caller was limited: %v", err)
+	}
+	other := &grpc.UnaryServerInfo{FullMethod: "/main.UrlShortener/GetURLStats"}
+	if _, err := rl.UnaryByPrincipal(alice, nil, other, okHandler); err != nil {
+		t.Errorf("method without a limit was limited: %v", err)
+	}
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Limit) (bool, time.Duration, error) {
+	return false, 0, errors.New("redis unreachable")
+}
+
+func TestLimiterFailureLetsRequestsThrough(t *testing.T) {
+	rl := &RateLimiter{Limiter: failingLimiter{}, ByIP: ratelimit.Policy{"*": {Rate: 1, Burst: 1}}}
+	if _, err := rl.UnaryByIP(fromProxy("203.0.113.1"), nil, shortenInfo, okHandler); err != nil {
+		t.Errorf("UnaryByIP with a failing limiter = %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	"google.golang.org/grpc"
+)
+
+// validator is implemented by every request message generated by
+// protoc-gen-validate from the rules in main.proto.
+type validator interface {
+	ValidateAll() error
+}
+
+// pgvFieldError is implemented by the per-message ValidationError types.
+type pgvFieldError interface {
+	Field() string
+	Reason() string
+}
+
+// pgvMultiError is implemented by the per-message MultiError types.
+type pgvMultiError interface {
+	AllErrors() []error
+}
+
+// ValidationInterceptor rejects requests that break the validation rules
+// declared in main.proto with InvalidArgument, listing every violated field,
+// before the handler runs.
+func ValidationInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	v, ok := req.(validator)
+	if !ok {
+		return handler(ctx, req)
+	}
+	if err := v.ValidateAll(); err != nil {
+		return nil, utils.ErrorHandler(toFieldErrors(err), "invalid request")
+	}
+	return handler(ctx, req)
+}
+
+func toFieldErrors(err error) utils.FieldErrors {
+	errs := []error{err}
+	if multi, ok := err.(pgvMultiError); ok {
+		errs = multi.AllErrors()
+	}
+
+	fieldErrs := make(utils.FieldErrors, 0, len(errs))
+	for _, e := range errs {
+		if fe, ok := e.(pgvFieldError); ok {
+			fieldErrs = append(fieldErrs, &utils.FieldError{Field: protoFieldName(fe.Field()), Description: fe.Reason()})
+		} else {
+			fieldErrs = append(fieldErrs, &utils.FieldError{Description: e.Error()})
+		}
+	}
+	return fieldErrs
+}
+
+// protoFieldName turns the Go field name protoc-gen-validate reports, such
+// as "OriginalUrl", into the proto name the handlers use, "original_url".
+func protoFieldName(goName string) string {
+	var b strings.Builder
+	for i, r := range goName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
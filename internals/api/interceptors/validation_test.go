@@ -0,0 +1,69 @@
+package interceptors
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	mainpb "github.com/aayushxrj/aws-url-shortner/proto/gen"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var shortenInfo = &grpc.UnaryServerInfo{FullMethod: "/main.UrlShortener/ShortenURL"}
+
+func okHandler(context.Context, any) (any, error) { return "ok", nil }
+
+func TestValidationInterceptorListsViolatedFields(t *testing.T) {
+	called := false
+	handler := func(context.Context, any) (any, error) {
+		called = true
+		return "ok", nil
+	}
+	req := &mainpb.ShortenURLRequest{
+		OriginalUrl:     "not a url",
+		ExpireInSeconds: -1,
+		CustomAlias:     "-x",
+	}
+
+	_, err := ValidationInterceptor(context.Background(), req, shortenInfo, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ValidationInterceptor = %v, want INVALID_ARGUMENT", err)
+	}
+	if called {
+		t.Error("the handler ran for an invalid request")
+	}
+
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	slices.Sort(fields)
+	fields = slices.Compact(fields)
+	if want := []string{"custom_alias", "expire_in_seconds", "original_url"}; !slices.Equal(fields, want) {
+		t.Errorf("field violations = %v, want %v", fields, want)
+	}
+}
+
+func TestValidationInterceptorPassesValidRequests(t *testing.T) {
+	for _, req := range []any{
+		&mainpb.ShortenURLRequest{OriginalUrl: "https://example.com/", ExpireInSeconds: 60, CustomAlias: "launch-2026"},
+		// international domains are checked, and punycode encoded, by the
+		// handlers
+		&mainpb.ShortenURLRequest{OriginalUrl: "https://example.com/", Domain: "Bücher.Example."},
+		&mainpb.RegisterDomainRequest{Domain: "Bücher.Example."},
+		&mainpb.VerifyDomainRequest{Domain: "bücher.example"},
+		"not a generated message",
+	} {
+		resp, err := ValidationInterceptor(context.Background(), req, shortenInfo, okHandler)
+		if err != nil || resp != "ok" {
+			t.Errorf("ValidationInterceptor(%v) = %v, %v, want the handler's response", req, resp, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// apiKeyPrefix marks API keys so they can be told apart from other bearer
+// credentials.
+const apiKeyPrefix = "usk_"
+
+// NewAPIKey returns a fresh key id and the plaintext key embedding it. Keys
+// look like usk_<id>_<secret>, with a 128 bit secret.
+func NewAPIKey() (id, key string) {
+	id = rand.Text()[:12]
+	return id, apiKeyPrefix + id + "_" + rand.Text()
+}
+
+// ParseAPIKey extracts the key id from a plaintext key. ok is false when the
+// value is not shaped like an API key.
+func ParseAPIKey(key string) (id string, ok bool) {
+	rest, found := strings.CutPrefix(key, apiKeyPrefix)
+	if !found {
+		return "", false
+	}
+	id, secret, found := strings.Cut(rest, "_")
+	if !found || id == "" || secret == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// HashAPIKey returns the value stored in place of the plaintext key. Keys
+// carry enough entropy that a fast hash is sufficient.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchAPIKey reports, in constant time, whether key hashes to hash.
+func MatchAPIKey(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(hash)) == 1
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewAPIKeyRoundTrips(t *testing.T) {
+	id, key := NewAPIKey()
+	if !strings.HasPrefix(key, apiKeyPrefix+id+"_") {
+		t.Fatalf("key %q does not embed id %q", key, id)
+	}
+	if got, ok := ParseAPIKey(key); !ok || got != id {
+		t.Errorf("ParseAPIKey = %q, %v, want %q", got, ok, id)
+	}
+	if other, _ := NewAPIKey(); other == id {
+		t.Errorf("NewAPIKey returned id %q twice", id)
+	}
+}
+
+func TestParseAPIKeyRejectsOtherCredentials(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"usk_",
+		"usk_ID",
+		"usk_ID_",
+		"usk__SECRET",
+		"sk_ID_SECRET",
+		"eyJhbGciOiJFUzI1NiJ9.e30.sig",
+	} {
+		if id, ok := ParseAPIKey(key); ok {
+			t.Errorf("ParseAPIKey(%q) = %q, want not ok", key, id)
+		}
+	}
+	// Secrets may contain the separator.
+	if id, ok := ParseAPIKey("usk_ID_SE_CRET"); !ok || id != "ID" {
+		t.Errorf("ParseAPIKey of a secret with an underscore = %q, %v", id, ok)
+	}
+}
+
+func TestMatchAPIKey(t *testing.T) {
+	_, key := NewAPIKey()
+	hash := HashAPIKey(key)
+	if hash == key || strings.Contains(hash, key) {
+		t.Fatal("HashAPIKey leaks the plaintext key")
+	}
+	if HashAPIKey(key) != hash {
+		t.Error("HashAPIKey is not deterministic")
+	}
+	if !MatchAPIKey(key, hash) {
+		t.Error("MatchAPIKey rejected the right key")
+	}
+	if MatchAPIKey(key+"x", hash) || MatchAPIKey(key, "") {
+		t.Error("MatchAPIKey accepted a wrong key or hash")
+	}
+}
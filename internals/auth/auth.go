@@ -0,0 +1,64 @@
+// Package auth describes who is calling the gRPC API and what they may do.
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// Scope is a permission granted to a caller. Scopes are ordered: admin
+// implies write, and write implies read.
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+var scopeRank = map[Scope]int{
+	ScopeRead:  1,
+	ScopeWrite: 2,
+	ScopeAdmin: 3,
+}
+
+// ParseScope validates a scope name.
+func ParseScope(name string) (Scope, error) {
+	s := Scope(name)
+	if _, ok := scopeRank[s]; !ok {
+		return "", fmt.Errorf("unknown scope %q", name)
+	}
+	return s, nil
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// Subject identifies the caller, see KeySubject and TokenSubject.
+	Subject string
+	// Roles are the roles asserted by a bearer token; empty for API keys.
+	Roles  []string
+	Scopes []Scope
+}
+
+// Allows reports whether any of the principal's scopes covers required.
+func (p *Principal) Allows(required Scope) bool {
+	for _, s := range p.Scopes {
+		if scopeRank[s] >= scopeRank[required] {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal stored in ctx, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// jwksRefetchInterval rate limits refetches triggered by an unknown key id,
+// so tokens with made up key ids cannot hammer the identity provider.
+const jwksRefetchInterval = 30 * time.Second
+
+// JWKS is a JSON Web Key Set read from an http(s) URL or, for offline use
+// and tests, a local file. The set is reloaded once it is older than the
+// refresh interval, or early when a token names a key id it does not hold,
+// so signing key rotation is picked up without a restart.
+type JWKS struct {
+	source  string
+	refresh time.Duration
+	client  *http.Client
+
+	// reloadMu serialises reloads.
+	reloadMu sync.Mutex
+
+	mu  sync.RWMutex
+	set *jose.JSONWebKeySet
+	// checkedAt is the time of the last load attempt, successful or not.
+	checkedAt time.Time
+}
+
+// NewJWKS loads the key set at source, a URL or a file path.
+func NewJWKS(ctx context.Context, source string, refresh time.Duration) (*JWKS, error) {
+	j := &JWKS{
+		source:  source,
+		refresh: refresh,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+	if err := j.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Reload fetches the key set again. On error the previous keys stay active.
+func (j *JWKS) Reload(ctx context.Context) error {
+	data, err := j.read(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load jwks %s: %w", j.source, err)
+	}
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse jwks %s: %w", j.source, err)
+	}
+
+	j.mu.Lock()
+	j.set, j.checkedAt = &set, time.Now()
+	j.mu.Unlock()
+	return nil
+}
+
+func (j *JWKS) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(j.source, "https://") && !strings.HasPrefix(j.source, "http://") {
+		return os.ReadFile(j.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// Key returns the signing key with the given id. A token without a key id
+// is accepted only when the set holds a single key.
+func (j *JWKS) Key(ctx context.Context, kid string) (*jose.JSONWebKey, bool) {
+	set, checkedAt := j.snapshot()
+	if time.Since(checkedAt) >= j.refresh {
+		set = j.reloadIfOlder(ctx, j.refresh)
+	}
+	if key, ok := findKey(set, kid); ok {
+		return key, true
+	}
+	if kid != "" {
+		return findKey(j.reloadIfOlder(ctx, jwksRefetchInterval), kid)
+	}
+	return nil, false
+}
+
+func (j *JWKS) snapshot() (*jose.JSONWebKeySet, time.Time) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.set, j.checkedAt
+}
+
+// reloadIfOlder reloads the set unless the last attempt was within age, and
+// returns the current set. Concurrent callers share a single reload.
+func (j *JWKS) reloadIfOlder(ctx context.Context, age time.Duration) *jose.JSONWebKeySet {
+	j.reloadMu.Lock()
+	defer j.reloadMu.Unlock()
+
+	if _, checkedAt := j.snapshot(); time.Since(checkedAt) >= age {
+		if err := j.Reload(ctx); err != nil {
+			log.Printf("jwks: %v", err)
+			// keep serving the old keys without retrying on every request
+			j.mu.Lock()
+			j.checkedAt = time.Now()
+			j.mu.Unlock()
+		}
+	}
+	set, _ := j.snapshot()
+	return set
+}
+
+// findKey picks the signing key for kid. Without a key id the choice must
+// be unambiguous.
+func findKey(set *jose.JSONWebKeySet, kid string) (*jose.JSONWebKey, bool) {
+	candidates := set.Keys
+	if kid != "" {
+		candidates = set.Key(kid)
+	}
+
+	var found *jose.JSONWebKey
+	for i := range candidates {
+		if use := candidates[i].Use; use != "" && use != "sig" {
+			continue
+		}
+		if found != nil {
+			return nil, false
+		}
+		found = &candidates[i]
+	}
+	return found, found != nil
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// ErrInvalidToken wraps every reason a bearer token is rejected. Errors
+// that do not wrap it, such as a failed JWKS fetch, are server side.
+var ErrInvalidToken = errors.New("invalid token")
+
+// signatureAlgorithms are the asymmetric algorithms accepted in tokens.
+// HMAC is left out so that a public key can never be used as a shared
+// secret.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTVerifier checks signed JWTs issued by an OIDC provider.
+type JWTVerifier struct {
+	Keys *JWKS
+	// Issuer must match the "iss" claim. It is required, since token
+	// subjects are only unique per issuer.
+	Issuer string
+	// Audience must be one of the "aud" claim values.
+	Audience string
+	// RolesClaim names the claim holding the caller's roles, as a list or a
+	// space separated string. Dots select nested claims, e.g.
+	// "realm_access.roles". Defaults to "roles".
+	RolesClaim string
+	// DefaultScopes are granted to every valid token.
+	DefaultScopes []Scope
+	// RoleScopes grants a scope to tokens carrying a role. Roles not listed
+	// grant nothing, whatever they are called.
+	RoleScopes map[string]Scope
+	// Leeway allows for clock skew when checking exp, nbf and iat.
+	Leeway time.Duration
+}
+
+// Verify checks the token's signature and claims and returns the caller it
+// identifies.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	if v.Issuer == "" {
+		return nil, errors.New("jwt verifier has no issuer")
+	}
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	key, ok := v.Keys.Key(ctx, tok.Headers[0].KeyID)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key", ErrInvalidToken)
+	}
+
+	var (
+		claims jwt.Claims
+		extra  map[string]any
+	)
+	if err := tok.Claims(key.Public(), &claims, &extra); err != nil {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: token has no expiry", ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidToken)
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      v.Issuer,
+		AnyAudience: jwt.Audience{v.Audience},
+		Time:        time.Now(),
+	}, v.Leeway)
+	switch {
+	case errors.Is(err, jwt.ErrExpired):
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidToken)
+	case errors.Is(err, jwt.ErrInvalidAudience):
+		return nil, fmt.Errorf("%w: token is not meant for this service", ErrInvalidToken)
+	case errors.Is(err, jwt.ErrInvalidIssuer):
+		return nil, fmt.Errorf("%w: untrusted issuer", ErrInvalidToken)
+	case err != nil:
+		return nil, fmt.Errorf("%w: token is not valid yet", ErrInvalidToken)
+	}
+
+	principal := &Principal{
+		Subject: TokenSubject(claims.Issuer, claims.Subject),
+		Roles:   rolesFromClaims(extra, v.rolesClaim()),
+		Scopes:  append([]Scope(nil), v.DefaultScopes...),
+	}
+	for _, role := range principal.Roles {
+		if scope, ok := v.RoleScopes[role]; ok {
+			principal.Scopes = append(principal.Scopes, scope)
+		}
+	}
+	return principal, nil
+}
+
+// ParseRoleScopes parses a comma separated list of role=scope entries for
+// JWTVerifier.RoleScopes, for example:
+//
+//	shortener-admins=admin,editors=write
+func ParseRoleScopes(spec string) (map[string]Scope, error) {
+	roles := map[string]Scope{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		role, name, ok := strings.Cut(entry, "=")
+		if !ok || role == "" {
+			return nil, fmt.Errorf("role scope %q: expected role=scope", entry)
+		}
+		scope, err := ParseScope(name)
+		if err != nil {
+			return nil, fmt.Errorf("role scope %q: %w", entry, err)
+		}
+		roles[role] = scope
+	}
+	return roles, nil
+}
+
+func (v *JWTVerifier) rolesClaim() string {
+	if v.RolesClaim != "" {
+		return v.RolesClaim
+	}
+	return "roles"
+}
+
+// rolesFromClaims reads the roles at the dotted path in claims.
+func rolesFromClaims(claims map[string]any, path string) []string {
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[name]
+	}
+
+	switch value := value.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		roles := make([]string, 0, len(value))
+		for _, r := range value {
+			if s, ok := r.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	}
+	return nil
+}
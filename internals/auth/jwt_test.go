@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	testIssuer   = "https://issuer.example"
+	testAudience = "shortener"
+)
+
+// newTestVerifier returns a verifier trusting a fresh key with id "k1",
+// and a function signing claims with that key.
+func newTestVerifier(t *testing.T) (*JWTVerifier, func(claims ...any) string) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk := jose.JSONWebKey{Key: priv, KeyID: "k1", Algorithm: string(jose.ES256), Use: "sig"}
+
+	set, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk.Public()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, set, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := NewJWKS(context.Background(), path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jwk}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(claims ...any) string {
+		b := jwt.Signed(signer)
+		for _, c := range claims {
+			b = b.Claims(c)
+		}
+		token, err := b.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	return &JWTVerifier{
+		Keys:          keys,
+		Issuer:        testIssuer,
+		Audience:      testAudience,
+		DefaultScopes: []Scope{ScopeRead},
+	}, sign
+}
+
+func validClaims() jwt.Claims {
+	now := time.Now()
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "alice",
+		Audience: jwt.Audience{"other", testAudience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+func TestJWTVerifierAcceptsValidTokens(t *testing.T) {
+	v, sign := newTestVerifier(t)
+
+	extra := map[string]any{"realm": map[string]any{"roles": []string{"admin", "auditor"}}}
+
+	p, err := v.Verify(context.Background(), sign(validClaims(), extra))
+	if err != nil {
+		t.Fatalf("Verify = %v", err)
+	}
+	if len(p.Roles) != 0 || p.Allows(ScopeWrite) {
+		t.Errorf("roles outside RolesClaim were used: %v, %v", p.Roles, p.Scopes)
+	}
+
+	v.RolesClaim = "realm.roles"
+	v.RoleScopes = map[string]Scope{"auditor": ScopeWrite}
+	p, err = v.Verify(context.Background(), sign(validClaims(), extra))
+	if err != nil {
+		t.Fatalf("Verify = %v", err)
+	}
+	if want := TokenSubject(testIssuer, "alice"); p.Subject != want {
+		t.Errorf("Subject = %q, want %q", p.Subject, want)
+	}
+	if !slices.Equal(p.Roles, []string{"admin", "auditor"}) {
+		t.Errorf("Roles = %v", p.Roles)
+	}
+	// Only the mapped role grants a scope; "admin" is just a name.
+	if !p.Allows(ScopeWrite) || p.Allows(ScopeAdmin) {
+		t.Errorf("Scopes = %v, want read and write", p.Scopes)
+	}
+}
+
+func TestJWTVerifierRequiresAnIssuer(t *testing.T) {
+	v, sign := newTestVerifier(t)
+	v.Issuer = ""
+	_, err := v.Verify(context.Background(), sign(validClaims()))
+	if err == nil || errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify without an issuer = %v, want a server side error", err)
+	}
+}
+
+func TestParseRoleScopes(t *testing.T) {
+	roles, err := ParseRoleScopes(" shortener-admins=admin, editors=write,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 2 || roles["shortener-admins"] != ScopeAdmin || roles["editors"] != ScopeWrite {
+		t.Errorf("ParseRoleScopes = %v", roles)
+	}
+	if roles, err := ParseRoleScopes(""); err != nil || len(roles) != 0 {
+		t.Errorf("ParseRoleScopes(\"\") = %v, %v, want no roles", roles, err)
+	}
+	for _, spec := range []string{"admin", "=admin", "editors=owner"} {
+		if _, err := ParseRoleScopes(spec); err == nil {
+			t.Errorf("ParseRoleScopes(%q) succeeded", spec)
+		}
+	}
+}
+
+func TestJWTVerifierRejects(t *testing.T) {
+	v, sign := newTestVerifier(t)
+	v.Leeway = time.Minute
+	now := time.Now()
+
+	tests := map[string]func(c *jwt.Claims){
+		"expired":             func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(now.Add(-2 * time.Minute)) },
+		"without expiry":      func(c *jwt.Claims) { c.Expiry = nil },
+		"not yet valid":       func(c *jwt.Claims) { c.NotBefore = jwt.NewNumericDate(now.Add(time.Hour)) },
+		"for another service": func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} },
+		"without audience":    func(c *jwt.Claims) { c.Audience = nil },
+		"from another issuer": func(c *jwt.Claims) { c.Issuer = "https://evil.example" },
+		"without subject":     func(c *jwt.Claims) { c.Subject = "" },
+	}
+	for name, mutate := range tests {
+		claims := validClaims()
+		mutate(&claims)
+		if _, err := v.Verify(context.Background(), sign(claims)); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Verify of a token %s = %v, want ErrInvalidToken", name, err)
+		}
+	}
+
+	// Within the leeway, an expired token still passes.
+	claims := validClaims()
+	claims.Expiry = jwt.NewNumericDate(now.Add(-30 * time.Second))
+	if _, err := v.Verify(context.Background(), sign(claims)); err != nil {
+		t.Errorf("Verify of a token expired within the leeway = %v", err)
+	}
+
+	if _, err := v.Verify(context.Background(), "not.a.token"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify of garbage = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestJWTVerifierRejectsOtherKeys(t *testing.T) {
+	v, _ := newTestVerifier(t)
+	_, foreign := newTestVerifier(t)
+
+	if _, err := v.Verify(context.Background(), foreign(validClaims())); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify of a token signed by another key = %v, want ErrInvalidToken", err)
+	}
+}
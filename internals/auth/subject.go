@@ -0,0 +1,27 @@
+package auth
+
+// Subjects are namespaced by the kind of credential they come from, so that
+// credentials of different kinds can never name the same caller:
+//
+//	key:<key id>          API keys
+//	key:bootstrap         the bootstrap key
+//	jwt:<issuer>|<sub>    bearer tokens, by "iss" and "sub" claim
+const (
+	keySubjectPrefix   = "key:"
+	tokenSubjectPrefix = "jwt:"
+
+	// BootstrapSubject is the subject of the bootstrap key. Generated key
+	// ids are upper case, so it cannot collide with a stored key.
+	BootstrapSubject = keySubjectPrefix + "bootstrap"
+)
+
+// KeySubject returns the subject of the API key with the given id.
+func KeySubject(keyID string) string {
+	return keySubjectPrefix + keyID
+}
+
+// TokenSubject returns the subject of a bearer token with the given "iss"
+// and "sub" claims.
+func TokenSubject(issuer, subject string) string {
+	return tokenSubjectPrefix + issuer + "|" + subject
+}
@@ -0,0 +1,15 @@
+package auth
+
+import "testing"
+
+func TestSubjectsDoNotCollide(t *testing.T) {
+	if KeySubject("bootstrap") != BootstrapSubject {
+		t.Fatal("the bootstrap subject left the key namespace")
+	}
+	if KeySubject("user-42") == TokenSubject("", "user-42") {
+		t.Error("a key id and a token subject map to the same subject")
+	}
+	if TokenSubject("https://a.example/", "user-42") == TokenSubject("https://b.example/", "user-42") {
+		t.Error("tokens from different issuers map to the same subject")
+	}
+}
@@ -0,0 +1,290 @@
+// Package clicks counts redirects in memory and writes them to the store in
+// batches, so a burst of traffic costs one write per link per flush instead
+// of one write per click.
+package clicks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// Incrementer is the part of repository.URLStore the aggregator writes to.
+type Incrementer interface {
+	IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error)
+}
+
+// Config tunes an Aggregator. Zero or nil fields take the defaults below.
+type Config struct {
+	// FlushInterval is how often pending counts are written. Default 1s.
+	FlushInterval time.Duration
+	// MaxPending bounds the number of links with unwritten counts. Clicks
+	// on further links are dropped until the next flush, which starts early
+	// once the buffer is half full. Default 10000.
+	MaxPending int
+	// Concurrency is how many writes a flush runs at once. Default 8.
+	Concurrency int
+	// Retries is how often a failed write is retried within a flush before
+	// its count is put back for the next one. Default 3, zero for none.
+	Retries *int
+	// RetryBackoff is the wait before the first retry, doubled on each
+	// further one. Default 100ms.
+	RetryBackoff time.Duration
+	// WriteTimeout bounds every single write. Default 5s.
+	WriteTimeout time.Duration
+}
+
+func (c Config) withDefaults() Config {
+	if c.FlushInterval <= 0 {
+		c.FlushInterval = time.Second
+	}
+	if c.MaxPending <= 0 {
+		c.MaxPending = 10000
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = 8
+	}
+	retries := 3
+	if c.Retries != nil {
+		retries = max(*c.Retries, 0)
+	}
+	c.Retries = &retries
+	if c.RetryBackoff <= 0 {
+		c.RetryBackoff = 100 * time.Millisecond
+	}
+	if c.WriteTimeout <= 0 {
+		c.WriteTimeout = 5 * time.Second
+	}
+	return c
+}
+
+// Stats are the counters of an Aggregator since it started, in clicks
+// unless noted otherwise.
+type Stats struct {
+	Received  int64 `json:"received"`  // accepted into the buffer
+	Dropped   int64 `json:"dropped"`   // refused because the buffer was full
+	Written   int64 `json:"written"`   // stored
+	Discarded int64 `json:"discarded"` // for links deleted or expired before the flush
+	Failed    int64 `json:"failed"`    // lost after the last retry on shutdown, or requeued into a full buffer
+	Retries   int64 `json:"retries"`   // retried writes
+	Flushes   int64 `json:"flushes"`   // completed flushes
+
+	PendingLinks  int64 `json:"pending_links"`  // links with unwritten counts
+	PendingClicks int64 `json:"pending_clicks"` // unwritten clicks
+}
+
+// Aggregator buffers click increments per short id and flushes them in the
+// background. Counting is at least once: a write that times out after the
+// store applied it is retried and counted twice.
+type Aggregator struct {
+	store Incrementer
+	cfg   Config
+
+	mu      sync.Mutex
+	pending map[string]int64
+	clicks  int64 // sum of pending
+
+	// flushMu keeps the background loop and Shutdown from flushing at the
+	// same time.
+	flushMu sync.Mutex
+	full    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	received, dropped, written, discarded, failed, retries, flushes atomic.Int64
+}
+
+// New returns an Aggregator writing to store and starts its flush loop.
+// Call Shutdown to stop it.
+func New(store Incrementer, cfg Config) *Aggregator {
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &Aggregator{
+		store:   store,
+		cfg:     cfg.withDefaults(),
+		pending: make(map[string]int64),
+		full:    make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Add counts one click on shortID. It never blocks and reports false when
+// the click was dropped because the buffer is full.
+func (a *Aggregator) Add(shortID string) bool {
+	a.mu.Lock()
+	_, ok := a.pending[shortID]
+	if !ok && len(a.pending) >= a.cfg.MaxPending {
+		a.mu.Unlock()
+		a.dropped.Add(1)
+		return false
+	}
+	a.pending[shortID]++
+	a.clicks++
+	halfFull := len(a.pending) >= a.cfg.MaxPending/2
+	a.mu.Unlock()
+
+	a.received.Add(1)
+	if halfFull {
+		select {
+		case a.full <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+// Stats returns the current counters.
+func (a *Aggregator) Stats() Stats {
+	a.mu.Lock()
+	links, clicks := int64(len(a.pending)), a.clicks
+	a.mu.Unlock()
+
+	return Stats{
+		Received:      a.received.Load(),
+		Dropped:       a.dropped.Load(),
+		Written:       a.written.Load(),
+		Discarded:     a.discarded.Load(),
+		Failed:        a.failed.Load(),
+		Retries:       a.retries.Load(),
+		Flushes:       a.flushes.Load(),
+		PendingLinks:  links,
+		PendingClicks: clicks,
+	}
+}
+
+// Shutdown stops the flush loop and writes everything still pending,
+// retrying failed writes until ctx is done. It returns an error if any
+// clicks could not be written. Clicks added afterwards are not flushed.
+func (a *Aggregator) Shutdown(ctx context.Context) error {
+	// cancelling interrupts the loop's retries; what they did not write is
+	// back in the buffer for the final flush
+	a.cancel()
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the flush loop: %w", ctx.Err())
+	}
+
+	if lost := a.flush(ctx, false); lost > 0 {
+		return fmt.Errorf("%d clicks could not be written", lost)
+	}
+	return nil
+}
+
+func (a *Aggregator) run() {
+	defer close(a.done)
+	ticker := time.NewTicker(a.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-a.full:
+		}
+		a.flush(a.ctx, true)
+	}
+}
+
+// flush writes every pending count, a.cfg.Concurrency at a time. Counts
+// that still fail after the retries go back into the buffer with requeue
+// and are lost otherwise; flush returns the number of lost clicks.
+func (a *Aggregator) flush(ctx context.Context, requeue bool) int64 {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	a.mu.Lock()
+	batch := a.pending
+	if len(batch) == 0 {
+		a.mu.Unlock()
+		return 0
+	}
+	a.pending = make(map[string]int64, len(batch))
+	a.clicks = 0
+	a.mu.Unlock()
+
+	var (
+		wg             sync.WaitGroup
+		sem            = make(chan struct{}, a.cfg.Concurrency)
+		lost, requeued atomic.Int64
+	)
+	for shortID, n := range batch {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := a.write(ctx, shortID, n)
+			switch {
+			case err == nil:
+				a.written.Add(n)
+			case errors.Is(err, repository.ErrNotFound) || errors.Is(err, repository.ErrExpired):
+				a.discarded.Add(n)
+			case requeue && a.requeue(shortID, n):
+				requeued.Add(n)
+			default:
+				log.Printf("failed to increment clicks for %s by %d: %v", shortID, n, err)
+				a.failed.Add(n)
+				lost.Add(n)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := requeued.Load(); n > 0 {
+		log.Printf("click flush: %d clicks could not be written, retrying next flush", n)
+	}
+	a.flushes.Add(1)
+	return lost.Load()
+}
+
+// write adds n clicks to shortID, retrying with exponential backoff.
+func (a *Aggregator) write(ctx context.Context, shortID string, n int64) error {
+	backoff := a.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		writeCtx, cancel := context.WithTimeout(ctx, a.cfg.WriteTimeout)
+		_, err := a.store.IncrementClicks(writeCtx, shortID, n)
+		cancel()
+		if err == nil || errors.Is(err, repository.ErrNotFound) || errors.Is(err, repository.ErrExpired) {
+			return err
+		}
+		if attempt == *a.cfg.Retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		a.retries.Add(1)
+	}
+}
+
+// requeue puts n clicks back into the buffer, merging them with clicks
+// counted since the flush started. It reports false if the buffer is full.
+func (a *Aggregator) requeue(shortID string, n int64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.pending[shortID]; !ok && len(a.pending) >= a.cfg.MaxPending {
+		return false
+	}
+	a.pending[shortID] += n
+	a.clicks += n
+	return true
+}
@@ -0,0 +1,210 @@
+package clicks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// fakeStore records increments. Writes for ids in fail return their error,
+// a transient one after failures runs out.
+type fakeStore struct {
+	mu       sync.Mutex
+	clicks   map[string]int64
+	writes   int
+	fail     map[string]error
+	failures int // remaining failures for every id, when positive
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{clicks: map[string]int64{}, fail: map[string]error{}}
+}
+
+var errUnavailable = errors.New("store unavailable")
+
+func (s *fakeStore) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writes++
+	if err := s.fail[shortID]; err != nil {
+		return 0, err
+	}
+	if s.failures > 0 {
+		s.failures--
+		return 0, errUnavailable
+	}
+	s.clicks[shortID] += n
+	return s.clicks[shortID], nil
+}
+
+func (s *fakeStore) get(shortID string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clicks[shortID]
+}
+
+// newManual returns an Aggregator whose flush loop is already stopped, so
+// tests decide when to flush.
+func newManual(store Incrementer, cfg Config) *Aggregator {
+	cfg.FlushInterval = time.Hour
+	a := New(store, cfg)
+	a.cancel()
+	<-a.done
+	return a
+}
+
+func retries(n int) *int { return &n }
+
+func TestConfigRetries(t *testing.T) {
+	for _, tc := range []struct {
+		retries *int
+		want    int
+	}{
+		{nil, 3},
+		{retries(0), 0},
+		{retries(-1), 0},
+		{retries(5), 5},
+	} {
+		if got := *(Config{Retries: tc.retries}).withDefaults().Retries; got != tc.want {
+			t.Errorf("Retries = %d after defaults, want %d", got, tc.want)
+		}
+	}
+}
+
+func TestAggregatorBatchesClicks(t *testing.T) {
+	store := newFakeStore()
+	a := newManual(store, Config{})
+
+	for _, id := range []string{"a", "b", "a", "a"} {
+		if !a.Add(id) {
+			t.Fatalf("Add(%q) was dropped", id)
+		}
+	}
+	if s := a.Stats(); s.PendingLinks != 2 || s.PendingClicks != 4 || s.Received != 4 {
+		t.Errorf("Stats before the flush = %+v", s)
+	}
+
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if store.get("a") != 3 || store.get("b") != 1 || store.writes != 2 {
+		t.Errorf("store = %v after %d writes, want a=3 b=1 in 2 writes", store.clicks, store.writes)
+	}
+	if s := a.Stats(); s.Written != 4 || s.PendingClicks != 0 || s.Flushes != 1 {
+		t.Errorf("Stats after the flush = %+v", s)
+	}
+}
+
+func TestAggregatorDropsWhenFull(t *testing.T) {
+	a := newManual(newFakeStore(), Config{MaxPending: 2})
+
+	if !a.Add("a") || !a.Add("b") {
+		t.Fatal("Add dropped a click below the limit")
+	}
+	if a.Add("c") {
+		t.Error("Add accepted a click on a new link into a full buffer")
+	}
+	if !a.Add("a") {
+		t.Error("Add dropped a click on a link that is already pending")
+	}
+	if s := a.Stats(); s.Dropped != 1 || s.Received != 3 {
+		t.Errorf("Stats = %+v", s)
+	}
+}
+
+func TestAggregatorRequeuesFailedWrites(t *testing.T) {
+	store := newFakeStore()
+	store.fail["a"] = errUnavailable
+	a := newManual(store, Config{Retries: retries(0)})
+
+	a.Add("a")
+	a.Add("a")
+	a.Add("b")
+	if lost := a.flush(context.Background(), true); lost != 0 {
+		t.Errorf("flush lost %d clicks, want them requeued", lost)
+	}
+	if s := a.Stats(); s.Written != 1 || s.PendingClicks != 2 || s.Failed != 0 {
+		t.Errorf("Stats after a failed write = %+v", s)
+	}
+
+	// Clicks counted meanwhile are merged with the requeued ones.
+	a.Add("a")
+	delete(store.fail, "a")
+	a.flush(context.Background(), true)
+	if got := store.get("a"); got != 3 {
+		t.Errorf("clicks of a = %d, want 3", got)
+	}
+	if s := a.Stats(); s.Written != 4 || s.PendingClicks != 0 {
+		t.Errorf("Stats after recovery = %+v", s)
+	}
+}
+
+func TestAggregatorRetriesWithinAFlush(t *testing.T) {
+	store := newFakeStore()
+	store.failures = 2
+	a := newManual(store, Config{Retries: retries(3), RetryBackoff: time.Millisecond})
+
+	a.Add("a")
+	if lost := a.flush(context.Background(), false); lost != 0 {
+		t.Fatalf("flush lost %d clicks", lost)
+	}
+	if store.get("a") != 1 {
+		t.Errorf("clicks of a = %d, want 1", store.get("a"))
+	}
+	if s := a.Stats(); s.Retries != 2 || s.Written != 1 {
+		t.Errorf("Stats = %+v", s)
+	}
+}
+
+func TestAggregatorDiscardsClicksOnMissingLinks(t *testing.T) {
+	store := newFakeStore()
+	store.fail["gone"] = repository.ErrNotFound
+	store.fail["old"] = repository.ErrExpired
+	a := newManual(store, Config{})
+
+	a.Add("gone")
+	a.Add("old")
+	a.Add("old")
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s := a.Stats(); s.Discarded != 3 || s.Failed != 0 || s.Retries != 0 {
+		t.Errorf("Stats = %+v", s)
+	}
+}
+
+func TestAggregatorShutdownReportsLostClicks(t *testing.T) {
+	store := newFakeStore()
+	store.fail["a"] = errUnavailable
+	a := newManual(store, Config{Retries: retries(1), RetryBackoff: time.Millisecond})
+
+	a.Add("a")
+	a.Add("a")
+	a.Add("b")
+	if err := a.Shutdown(context.Background()); err == nil {
+		t.Error("Shutdown did not report the lost clicks")
+	}
+	if s := a.Stats(); s.Failed != 2 || s.Written != 1 || s.PendingClicks != 0 {
+		t.Errorf("Stats = %+v", s)
+	}
+}
+
+func TestAggregatorFlushesInTheBackground(t *testing.T) {
+	store := newFakeStore()
+	a := New(store, Config{FlushInterval: 10 * time.Millisecond})
+	defer a.Shutdown(context.Background())
+
+	a.Add("a")
+	deadline := time.Now().Add(time.Second)
+	for store.get("a") != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("click was not flushed by the background loop")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
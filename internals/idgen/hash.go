@@ -0,0 +1,32 @@
+package idgen
+
+import (
+	"context"
+	"crypto/sha256"
+	"math/big"
+	"strconv"
+)
+
+// Hash derives the id from a salted SHA-256 of the original URL, so the
+// same URL always yields the same first candidate. When that candidate is
+// already taken the attempt number is mixed in to get the next one.
+type Hash struct {
+	alphabet string
+	salt     string
+}
+
+func NewHash(alphabet, salt string) *Hash {
+	return &Hash{alphabet: alphabet, salt: salt}
+}
+
+func (h *Hash) Generate(ctx context.Context, originalURL string, length, attempt int) (string, error) {
+	input := h.salt + "\x00" + originalURL
+	if attempt > 0 {
+		input += "\x00" + strconv.Itoa(attempt)
+	}
+	sum := sha256.Sum256([]byte(input))
+
+	n := new(big.Int).SetBytes(sum[:])
+	space := new(big.Int).Exp(big.NewInt(int64(len(h.alphabet))), big.NewInt(int64(length)), nil)
+	return encodeBig(n.Mod(n, space), h.alphabet, length), nil
+}
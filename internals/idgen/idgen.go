@@ -0,0 +1,94 @@
+package idgen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Base62 is the default alphabet used for short ids.
+	Base62 = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// Unambiguous is Base62 without characters that are easily confused
+	// when read aloud or printed: 0/O/o, 1/l/I.
+	Unambiguous = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// IDGenerator produces candidate short ids. Callers store the candidate with
+// a conditional write and call Generate again on collision, incrementing
+// attempt, so deterministic strategies can move on to another candidate.
+type IDGenerator interface {
+	Generate(ctx context.Context, originalURL string, length, attempt int) (string, error)
+}
+
+// Sequencer hands out unique, monotonically increasing numbers. It is
+// satisfied by the storage backends that can keep a durable counter.
+type Sequencer interface {
+	NextSequence(ctx context.Context) (uint64, error)
+}
+
+// Alphabet resolves a configured alphabet: either one of the preset names
+// "base62" and "unambiguous", or a literal string of unique characters. Only
+// letters, digits, '-' and '_' are allowed, matching the short_id rules in
+// main.proto.
+func Alphabet(name string) (string, error) {
+	switch name {
+	case "", "base62":
+		return Base62, nil
+	case "unambiguous":
+		return Unambiguous, nil
+	}
+	if len(name) < 16 {
+		return "", fmt.Errorf("alphabet must have at least 16 characters")
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return "", fmt.Errorf("alphabet may only contain letters, digits, '-' and '_'")
+		}
+		if strings.IndexByte(name[i+1:], name[i]) >= 0 {
+			return "", fmt.Errorf("alphabet has duplicate character %q", name[i])
+		}
+	}
+	return name, nil
+}
+
+// New builds the generator for strategy, one of "random", "counter",
+// "sqids" or "hash". seq is only needed by the sequence based strategies
+// and salt only by "sqids" and "hash".
+func New(strategy, alphabet, salt string, seq Sequencer) (IDGenerator, error) {
+	switch strategy {
+	case "", "random":
+		return NewRandom(alphabet), nil
+	case "counter", "sqids":
+		if seq == nil {
+			return nil, fmt.Errorf("id strategy %q needs a storage backend with a sequence counter", strategy)
+		}
+		if strategy == "counter" {
+			return NewCounter(seq, alphabet), nil
+		}
+		return NewSqids(seq, alphabet, salt), nil
+	case "hash":
+		return NewHash(alphabet, salt), nil
+	default:
+		return nil, fmt.Errorf("unknown id strategy %q", strategy)
+	}
+}
+
+// encode writes n in base len(alphabet), left-padded with the first
+// alphabet character to at least length characters.
+func encode(n uint64, alphabet string, length int) string {
+	base := uint64(len(alphabet))
+	var buf []byte
+	for n > 0 {
+		buf = append(buf, alphabet[n%base])
+		n /= base
+	}
+	for len(buf) < length {
+		buf = append(buf, alphabet[0])
+	}
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return string(buf)
+}
@@ -0,0 +1,129 @@
+package idgen
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// counter is a Sequencer starting at 1, like the storage backends.
+type counter struct{ n uint64 }
+
+func (c *counter) NextSequence(ctx context.Context) (uint64, error) {
+	c.n++
+	return c.n, nil
+}
+
+func TestAlphabet(t *testing.T) {
+	for name, want := range map[string]string{"": Base62, "base62": Base62, "unambiguous": Unambiguous, "0123456789abcdef": "0123456789abcdef"} {
+		if got, err := Alphabet(name); err != nil || got != want {
+			t.Errorf("Alphabet(%q) = %q, %v", name, got, err)
+		}
+	}
+	for _, name := range []string{"short", "0123456789abcdee", "0123456789abcde/"} {
+		if _, err := Alphabet(name); err == nil {
+			t.Errorf("Alphabet(%q) accepted", name)
+		}
+	}
+}
+
+// TestSqidsIsBijection checks that Sqids maps every sequence number of a
+// keyspace to a distinct id of the requested length, and only grows the id
+// once the keyspace is used up.
+func TestSqidsIsBijection(t *testing.T) {
+	const alphabet = "0123456789abcdef"
+	gen := NewSqids(&counter{}, alphabet, "salt")
+
+	seen := map[string]bool{}
+	// Sequence numbers 1..255 fit in the 16^2 keyspace, 256 does not.
+	for n := 1; n < 256; n++ {
+		id, err := gen.Generate(context.Background(), "", 2, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(id) != 2 || strings.Trim(id, alphabet) != "" {
+			t.Fatalf("id %d = %q, want 2 characters from the alphabet", n, id)
+		}
+		if seen[id] {
+			t.Fatalf("id %d = %q was already handed out", n, id)
+		}
+		seen[id] = true
+	}
+
+	id, err := gen.Generate(context.Background(), "", 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(id) != 3 {
+		t.Errorf("id past the keyspace = %q, want 3 characters", id)
+	}
+}
+
+func TestSqidsDependsOnSalt(t *testing.T) {
+	a, _ := NewSqids(&counter{}, Base62, "one").Generate(context.Background(), "", 6, 0)
+	b, _ := NewSqids(&counter{}, Base62, "two").Generate(context.Background(), "", 6, 0)
+	if a == b {
+		t.Errorf("different salts both produced %q", a)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	gen := NewCounter(&counter{}, "0123456789")
+	for _, want := range []string{"001", "002", "003"} {
+		if got, _ := gen.Generate(context.Background(), "", 3, 0); got != want {
+			t.Errorf("Generate = %q, want %q", got, want)
+		}
+	}
+
+	gen = NewCounter(&counter{n: 1233}, "0123456789")
+	if got, _ := gen.Generate(context.Background(), "", 3, 0); got != "1234" {
+		t.Errorf("Generate past the length = %q, want 1234", got)
+	}
+}
+
+func TestHash(t *testing.T) {
+	gen := NewHash(Base62, "salt")
+	ctx := context.Background()
+
+	first, _ := gen.Generate(ctx, "https://example.com", 7, 0)
+	again, _ := gen.Generate(ctx, "https://example.com", 7, 0)
+	if first != again {
+		t.Errorf("same URL produced %q and %q", first, again)
+	}
+	if len(first) != 7 || strings.Trim(first, Base62) != "" {
+		t.Errorf("Generate = %q, want 7 characters from the alphabet", first)
+	}
+	if retry, _ := gen.Generate(ctx, "https://example.com", 7, 1); retry == first {
+		t.Errorf("retry produced the same candidate %q", retry)
+	}
+	if other, _ := NewHash(Base62, "other").Generate(ctx, "https://example.com", 7, 0); other == first {
+		t.Errorf("different salts both produced %q", other)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	gen := NewRandom(Unambiguous)
+	for range 20 {
+		id, err := gen.Generate(context.Background(), "", 8, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(id) != 8 || strings.Trim(id, Unambiguous) != "" {
+			t.Fatalf("Generate = %q, want 8 characters from the alphabet", id)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, strategy := range []string{"", "random", "hash", "counter", "sqids"} {
+		if _, err := New(strategy, Base62, "salt", &counter{}); err != nil {
+			t.Errorf("New(%q) = %v", strategy, err)
+		}
+	}
+	if _, err := New("sqids", Base62, "salt", nil); err == nil {
+		t.Error("New accepted sqids without a sequencer")
+	}
+	if _, err := New("uuid", Base62, "", nil); err == nil {
+		t.Error("New accepted an unknown strategy")
+	}
+}
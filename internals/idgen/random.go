@@ -0,0 +1,29 @@
+package idgen
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+)
+
+// Random draws every character independently from crypto/rand.
+type Random struct {
+	alphabet string
+}
+
+func NewRandom(alphabet string) *Random {
+	return &Random{alphabet: alphabet}
+}
+
+func (r *Random) Generate(ctx context.Context, originalURL string, length, attempt int) (string, error) {
+	max := big.NewInt(int64(len(r.alphabet)))
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = r.alphabet[n.Int64()]
+	}
+	return string(b), nil
+}
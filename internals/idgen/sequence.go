@@ -0,0 +1,99 @@
+package idgen
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"math/rand"
+)
+
+// Counter encodes the next sequence number directly, giving short and
+// strictly increasing ids. Ids are predictable, so use Sqids when that
+// matters.
+type Counter struct {
+	seq      Sequencer
+	alphabet string
+}
+
+func NewCounter(seq Sequencer, alphabet string) *Counter {
+	return &Counter{seq: seq, alphabet: alphabet}
+}
+
+func (c *Counter) Generate(ctx context.Context, originalURL string, length, attempt int) (string, error) {
+	n, err := c.seq.NextSequence(ctx)
+	if err != nil {
+		return "", err
+	}
+	return encode(n, c.alphabet, length), nil
+}
+
+// Sqids obfuscates sequence numbers in the spirit of Hashids/Sqids: the
+// alphabet is shuffled by a salt and each number is mapped through a
+// bijection of the length-L keyspace, so consecutive numbers produce
+// unrelated looking ids that still never collide with each other.
+type Sqids struct {
+	seq      Sequencer
+	alphabet string
+	offset   *big.Int
+}
+
+func NewSqids(seq Sequencer, alphabet, salt string) *Sqids {
+	sum := sha256.Sum256([]byte(salt))
+	rng := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(sum[:8]))))
+
+	shuffled := []byte(alphabet)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	return &Sqids{
+		seq:      seq,
+		alphabet: string(shuffled),
+		offset:   new(big.Int).SetBytes(sum[8:16]),
+	}
+}
+
+func (s *Sqids) Generate(ctx context.Context, originalURL string, length, attempt int) (string, error) {
+	n, err := s.seq.NextSequence(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// grow the keyspace until it can hold n
+	base := big.NewInt(int64(len(s.alphabet)))
+	space := new(big.Int).Exp(base, big.NewInt(int64(length)), nil)
+	seq := new(big.Int).SetUint64(n)
+	for seq.Cmp(space) >= 0 {
+		space.Mul(space, base)
+		length++
+	}
+
+	x := new(big.Int).Mul(seq, multiplierFor(space, base))
+	x.Add(x, s.offset)
+	x.Mod(x, space)
+	return encodeBig(x, s.alphabet, length), nil
+}
+
+// multiplierFor picks a multiplier close to space/φ, which spreads
+// consecutive inputs across the whole keyspace. It must share no factor with
+// base (and so with space = base^L) for x -> x*m mod space to be a bijection.
+func multiplierFor(space, base *big.Int) *big.Int {
+	m := new(big.Int).Mul(space, big.NewInt(618_033_988))
+	m.Quo(m, big.NewInt(1_000_000_000))
+	one := big.NewInt(1)
+	for new(big.Int).GCD(nil, nil, m, base).Cmp(one) != 0 {
+		m.Add(m, one)
+	}
+	return m
+}
+
+func encodeBig(n *big.Int, alphabet string, length int) string {
+	base := big.NewInt(int64(len(alphabet)))
+	buf := make([]byte, length)
+	rem := new(big.Int)
+	n = new(big.Int).Set(n)
+	for i := length - 1; i >= 0; i-- {
+		n.QuoRem(n, base, rem)
+		buf[i] = alphabet[rem.Int64()]
+	}
+	return string(buf)
+}
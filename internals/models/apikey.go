@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// APIKey is a credential for the gRPC management API. Only a hash of the
+// key is stored; the plaintext is shown once when the key is created.
+type APIKey struct {
+	ID        string
+	Name      string
+	Hash      string   // hex SHA-256 of the full key
+	Scopes    []string // read, write and/or admin
+	CreatedAt time.Time
+	RevokedAt int64 // unix seconds, 0 while the key is active
+}
+
+// Revoked reports whether the key has been revoked.
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != 0
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Domain is a custom domain short links can be bound to. Links can only be
+// created on it once ownership has been proven with a DNS TXT record.
+type Domain struct {
+	Name string // lower case host name, without port
+	// VerificationToken is the value the TXT record must contain.
+	VerificationToken string
+	CreatedAt         time.Time
+	CreatedBy         string
+	// WorkspaceID is the workspace whose editors may create links on the
+	// domain, empty if only its creator and admins may.
+	WorkspaceID string
+	VerifiedAt  int64 // unix seconds, 0 until verified
+}
+
+// Verified reports whether ownership of the domain has been proven.
+func (d *Domain) Verified() bool {
+	return d.VerifiedAt != 0
+}
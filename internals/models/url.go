@@ -0,0 +1,58 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// URL is a shortened link as handled by the service, independent of the
+// storage backend it lives in.
+type URL struct {
+	ShortID     string
+	OriginalURL string
+	CreatedAt   time.Time
+	ExpireAt    int64 // unix seconds, 0 means the URL never expires
+	Clicks      int64
+	// Flagged marks a destination screening considered suspicious; the
+	// redirect server shows a warning page instead of redirecting.
+	Flagged bool
+	// OwnerID is the subject of the principal that created the link, empty
+	// for links created without authentication.
+	OwnerID string
+	// WorkspaceID is the workspace the link belongs to, empty for personal
+	// links.
+	WorkspaceID string
+}
+
+// JoinShortID returns the short id of the link id on a custom domain. Links
+// bound to a custom domain are stored as "<domain>/<id>", so the same id can
+// be taken on several domains; links on the primary domain, "", keep the
+// bare id.
+func JoinShortID(domain, id string) string {
+	if domain == "" {
+		return id
+	}
+	return domain + "/" + id
+}
+
+// SplitShortID is the inverse of JoinShortID.
+func SplitShortID(shortID string) (domain, id string) {
+	if i := strings.LastIndexByte(shortID, '/'); i >= 0 {
+		return shortID[:i], shortID[i+1:]
+	}
+	return "", shortID
+}
+
+// Expired reports whether the URL's expiry has passed at now.
+func (u *URL) Expired(now time.Time) bool {
+	return u.ExpireAt != 0 && u.ExpireAt <= now.Unix()
+}
+
+// URLUpdate describes a partial update to a URL. Nil fields are left
+// untouched.
+type URLUpdate struct {
+	OriginalURL *string
+	ExpireAt    *int64 // pointing at 0 clears the expiry
+	Flagged     *bool
+	WorkspaceID *string // pointing at "" makes the link personal
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Workspace is a team namespace whose links are shared by its members.
+type Workspace struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+// Role is a member's permission level inside a workspace. Roles are
+// ordered: admin can do everything editor can, and editor everything viewer
+// can.
+type Role string
+
+const (
+	RoleViewer Role = "viewer" // read links and stats
+	RoleEditor Role = "editor" // create, change and delete links
+	RoleAdmin  Role = "admin"  // manage members and move links out
+)
+
+var roleRank = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// ParseRole validates a role name.
+func ParseRole(name string) (Role, error) {
+	r := Role(name)
+	if _, ok := roleRank[r]; !ok {
+		return "", fmt.Errorf("unknown role %q", name)
+	}
+	return r, nil
+}
+
+// Allows reports whether r is at least required.
+func (r Role) Allows(required Role) bool {
+	return roleRank[r] >= roleRank[required]
+}
+
+// Member is a user's membership in a workspace.
+type Member struct {
+	WorkspaceID string
+	UserID      string // principal subject
+	Role        Role
+	AddedAt     time.Time
+}
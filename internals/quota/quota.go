@@ -0,0 +1,161 @@
+// Package quota enforces per owner caps on active links and on links
+// created per day. Owners are API key ids or JWT subjects.
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Names of the quotas, as reported in ExceededError.
+const (
+	ActiveLinks = "active_links"
+	DailyLinks  = "daily_links"
+)
+
+// Limits are the caps applied to every owner. Zero means unlimited.
+type Limits struct {
+	MaxActiveLinks int64
+	MaxDailyLinks  int64
+}
+
+// Usage is an owner's current consumption of its quotas.
+type Usage struct {
+	Limits
+	OwnerID     string
+	ActiveLinks int64
+	DailyLinks  int64
+	// DailyResetAt is when the daily count starts over, at midnight UTC.
+	DailyResetAt time.Time
+}
+
+// RemainingActiveLinks returns how many more links the owner may hold, or
+// -1 without a cap.
+func (u *Usage) RemainingActiveLinks() int64 {
+	return remaining(u.MaxActiveLinks, u.ActiveLinks)
+}
+
+// RemainingDailyLinks returns how many more links the owner may create
+// today, or -1 without a cap.
+func (u *Usage) RemainingDailyLinks() int64 {
+	return remaining(u.MaxDailyLinks, u.DailyLinks)
+}
+
+func remaining(limit, used int64) int64 {
+	if limit <= 0 {
+		return -1
+	}
+	return max(limit-used, 0)
+}
+
+// ExceededError is returned when creating a link would pass a quota.
+type ExceededError struct {
+	OwnerID string
+	Quota   string // ActiveLinks or DailyLinks
+	Used    int64
+	Limit   int64
+}
+
+func (e *ExceededError) Error() string {
+	if e.Quota == DailyLinks {
+		return fmt.Sprintf("daily link quota reached, %d of %d links created today", e.Used, e.Limit)
+	}
+	return fmt.Sprintf("active link quota reached, %d of %d links in use", e.Used, e.Limit)
+}
+
+func (e *ExceededError) StatusCode() codes.Code {
+	return codes.ResourceExhausted
+}
+
+func (e *ExceededError) StatusDetails() []protoadapt.MessageV1 {
+	return []protoadapt.MessageV1{&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{Subject: e.Quota + ":" + e.OwnerID, Description: e.Error()},
+		},
+	}}
+}
+
+// Enforcer checks owners against Limits. Daily counts are only kept, and
+// the daily cap only enforced, when Counts is set.
+type Enforcer struct {
+	URLs   repository.URLStore
+	Counts repository.QuotaStore
+	Limits Limits
+}
+
+// Day returns the UTC date t counts towards.
+func Day(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
+
+// Usage returns the current usage of ownerID.
+func (e *Enforcer) Usage(ctx context.Context, ownerID string) (*Usage, error) {
+	now := time.Now().UTC()
+	u := &Usage{
+		Limits:       e.Limits,
+		OwnerID:      ownerID,
+		DailyResetAt: time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var err error
+	if u.ActiveLinks, err = e.URLs.CountByOwner(ctx, ownerID); err != nil {
+		return nil, err
+	}
+	if e.Counts != nil {
+		if u.DailyLinks, err = e.Counts.DailyCreations(ctx, ownerID, Day(now)); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// Reserve checks the active link cap for ownerID and counts one creation
+// against today's cap, returning an ExceededError if either is reached.
+// The returned release undoes the count and must be called if the link
+// ends up not being created. Concurrent creations may briefly overshoot the
+// active link cap, which is checked by counting.
+func (e *Enforcer) Reserve(ctx context.Context, ownerID string) (release func(context.Context), err error) {
+	if err := e.CheckActive(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	if e.Counts == nil {
+		return func(context.Context) {}, nil
+	}
+	day := Day(time.Now())
+	if used, err := e.Counts.AddDailyCreation(ctx, ownerID, day, 1, e.Limits.MaxDailyLinks); err != nil {
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return nil, &ExceededError{OwnerID: ownerID, Quota: DailyLinks, Used: used, Limit: e.Limits.MaxDailyLinks}
+		}
+		return nil, err
+	}
+	return func(ctx context.Context) {
+		if _, err := e.Counts.AddDailyCreation(ctx, ownerID, day, -1, 0); err != nil {
+			log.Printf("failed to release daily creation of %s: %v", ownerID, err)
+		}
+	}, nil
+}
+
+// CheckActive returns an ExceededError if ownerID holds its cap of active
+// links, for links that become active again without being created.
+func (e *Enforcer) CheckActive(ctx context.Context, ownerID string) error {
+	if e.Limits.MaxActiveLinks <= 0 {
+		return nil
+	}
+	active, err := e.URLs.CountByOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	if active >= e.Limits.MaxActiveLinks {
+		return &ExceededError{OwnerID: ownerID, Quota: ActiveLinks, Used: active, Limit: e.Limits.MaxActiveLinks}
+	}
+	return nil
+}
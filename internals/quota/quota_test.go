@@ -0,0 +1,121 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+)
+
+func wantExceeded(t *testing.T, err error, quota string) {
+	t.Helper()
+	var exceeded *ExceededError
+	if !errors.As(err, &exceeded) || exceeded.Quota != quota {
+		t.Fatalf("err = %v, want the %s quota exceeded", err, quota)
+	}
+}
+
+func TestReserveDailyLinks(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	e := &Enforcer{URLs: store, Counts: store, Limits: Limits{MaxDailyLinks: 2}}
+
+	first, err := e.Reserve(ctx, "key:A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Reserve(ctx, "key:A"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.Reserve(ctx, "key:A")
+	wantExceeded(t, err, DailyLinks)
+
+	// Other owners have their own count.
+	if _, err := e.Reserve(ctx, "key:B"); err != nil {
+		t.Errorf("Reserve for another owner = %v", err)
+	}
+
+	// A released reservation frees its slot.
+	first(ctx)
+	if _, err := e.Reserve(ctx, "key:A"); err != nil {
+		t.Errorf("Reserve after a release = %v", err)
+	}
+
+	u, err := e.Usage(ctx, "key:A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.DailyLinks != 2 || u.RemainingDailyLinks() != 0 || u.RemainingActiveLinks() != -1 {
+		t.Errorf("Usage = %+v", u)
+	}
+	if want := time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour); !u.DailyResetAt.Equal(want) {
+		t.Errorf("DailyResetAt = %v, want %v", u.DailyResetAt, want)
+	}
+}
+
+func TestReserveReportsTheDailyCount(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	// The owner created three links before the cap was lowered to two.
+	if _, err := store.AddDailyCreation(ctx, "key:A", Day(time.Now()), 3, 0); err != nil {
+		t.Fatal(err)
+	}
+	e := &Enforcer{URLs: store, Counts: store, Limits: Limits{MaxDailyLinks: 2}}
+
+	_, err := e.Reserve(ctx, "key:A")
+	wantExceeded(t, err, DailyLinks)
+	var exceeded *ExceededError
+	if errors.As(err, &exceeded); exceeded.Used != 3 || exceeded.Limit != 2 {
+		t.Errorf("ExceededError = %+v, want 3 of 2 used", exceeded)
+	}
+}
+
+func TestReserveActiveLinks(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	e := &Enforcer{URLs: store, Counts: store, Limits: Limits{MaxActiveLinks: 2, MaxDailyLinks: 10}}
+
+	for _, u := range []*models.URL{
+		{ShortID: "a", OwnerID: "key:A"},
+		{ShortID: "b", OwnerID: "key:A"},
+		{ShortID: "c", OwnerID: "key:A", ExpireAt: time.Now().Add(-time.Minute).Unix()},
+	} {
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := e.Reserve(ctx, "key:A")
+	wantExceeded(t, err, ActiveLinks)
+	wantExceeded(t, e.CheckActive(ctx, "key:A"), ActiveLinks)
+
+	// A refused reservation is not counted against the day.
+	if n, _ := store.DailyCreations(ctx, "key:A", Day(time.Now())); n != 0 {
+		t.Errorf("daily count after a refused reservation = %d, want 0", n)
+	}
+
+	if err := store.Delete(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Reserve(ctx, "key:A"); err != nil {
+		t.Errorf("Reserve after deleting a link = %v", err)
+	}
+}
+
+func TestReserveWithoutLimits(t *testing.T) {
+	ctx := context.Background()
+	store := db.NewMemoryStore()
+	e := &Enforcer{URLs: store, Limits: Limits{MaxDailyLinks: 1}}
+
+	// Without Counts the daily cap is not enforced.
+	for range 3 {
+		release, err := e.Reserve(ctx, "key:A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		release(ctx)
+	}
+}
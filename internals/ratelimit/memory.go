@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often Memory drops buckets that have refilled.
+const sweepInterval = time.Minute
+
+// Memory is a Limiter local to the process.
+type Memory struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time // when the bucket will be full again
+}
+
+// NewMemory returns an empty in-process Limiter.
+func NewMemory() *Memory {
+	return &Memory{buckets: make(map[string]*bucket), lastSweep: time.Now()}
+}
+
+func (m *Memory) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	now := time.Now()
+	burst := float64(limit.Burst)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.lastSweep) > sweepInterval {
+		m.sweep(now)
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		m.buckets[key] = b
+	}
+	b.tokens = min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	allowed := b.tokens >= 1
+	var wait time.Duration
+	if allowed {
+		b.tokens--
+	} else {
+		wait = time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	}
+	b.full = now.Add(time.Duration((burst - b.tokens) / limit.Rate * float64(time.Second)))
+	return allowed, wait, nil
+}
+
+// sweep forgets full buckets; a new bucket starts full anyway.
+func (m *Memory) sweep(now time.Time) {
+	for key, b := range m.buckets {
+		if !now.Before(b.full) {
+			delete(m.buckets, key)
+		}
+	}
+	m.lastSweep = now
+}
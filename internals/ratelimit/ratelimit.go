@@ -0,0 +1,173 @@
+// Package ratelimit implements token bucket rate limits, kept either in
+// process memory or in Redis so that several instances share them.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Limit is a token bucket: it refills at Rate tokens per second and holds at
+// most Burst tokens. Every request takes one token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter takes a token from the bucket identified by key.
+type Limiter interface {
+	// Allow reports whether the request may proceed and, if not, how long
+	// until a token is available.
+	Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Unlimited is a Limiter that allows every request.
+type Unlimited struct{}
+
+func (Unlimited) Allow(context.Context, string, Limit) (bool, time.Duration, error) {
+	return true, 0, nil
+}
+
+// LimitedError is returned for requests over their limit.
+type LimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LimitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry in %s", e.RetryAfter.Round(time.Millisecond))
+}
+
+func (e *LimitedError) StatusCode() codes.Code {
+	return codes.ResourceExhausted
+}
+
+func (e *LimitedError) StatusDetails() []protoadapt.MessageV1 {
+	return []protoadapt.MessageV1{&errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)}}
+}
+
+// RetryAfterSeconds rounds d up to whole seconds, as used by the HTTP
+// Retry-After header.
+func RetryAfterSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// Redirect is the Policy name of the HTTP redirect server.
+const Redirect = "redirect"
+
+// Policy maps names to limits: gRPC method names such as "ShortenURL", or
+// Redirect for the HTTP redirect server. "*" applies to gRPC methods without
+// a limit of their own; redirects are only limited by a Redirect entry, so
+// that a limit meant for API calls does not throttle link traffic.
+type Policy map[string]Limit
+
+// For returns the limit applying to name. Full gRPC method names are
+// looked up by their last path element.
+func (p Policy) For(name string) (Limit, bool) {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if l, ok := p[name]; ok || name == Redirect {
+		return l, ok
+	}
+	l, ok := p["*"]
+	return l, ok
+}
+
+// ParsePolicy parses a comma separated list of name=rate[:burst] entries,
+// where rate is a count per second, minute or hour such as "10/s" or
+// "100/m". The burst defaults to the count. For example:
+//
+//	ShortenURL=10/s:20,*=100/m
+func ParsePolicy(spec string) (Policy, error) {
+	p := Policy{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("rate limit %q: expected name=rate", entry)
+		}
+		limit, err := parseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit %q: %w", entry, err)
+		}
+		p[strings.TrimSpace(name)] = limit
+	}
+	return p, nil
+}
+
+func parseLimit(value string) (Limit, error) {
+	rate, burst, hasBurst := strings.Cut(strings.TrimSpace(value), ":")
+	count, unit, ok := strings.Cut(rate, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate must look like 10/s")
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid count %q", count)
+	}
+
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("unknown unit %q, use s, m or h", unit)
+	}
+
+	limit := Limit{Rate: float64(n) / per.Seconds(), Burst: n}
+	if hasBurst {
+		b, err := strconv.Atoi(burst)
+		if err != nil || b <= 0 {
+			return Limit{}, fmt.Errorf("invalid burst %q", burst)
+		}
+		limit.Burst = b
+	}
+	return limit, nil
+}
+
+// ClientIP returns the address of the client that sent r. With trustProxy
+// the last X-Forwarded-For entry, the one appended by the proxy in front of
+// the server, is used instead of the connection's remote address.
+func ClientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if ip := LastForwardedFor(r.Header.Values("X-Forwarded-For")); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// LastForwardedFor returns the last address in a set of X-Forwarded-For
+// header values, or "" if there is none.
+func LastForwardedFor(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	last := values[len(values)-1]
+	if i := strings.LastIndex(last, ","); i >= 0 {
+		last = last[i+1:]
+	}
+	return strings.TrimSpace(last)
+}
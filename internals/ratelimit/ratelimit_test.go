@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy(" ShortenURL=10/s:20, *=100/m ,redirect=3600/h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Limit{
+		"ShortenURL": {Rate: 10, Burst: 20},
+		"*":          {Rate: 100.0 / 60, Burst: 100},
+		"redirect":   {Rate: 1, Burst: 3600},
+	}
+	for name, limit := range want {
+		if got := p[name]; got != limit {
+			t.Errorf("%s = %+v, want %+v", name, got, limit)
+		}
+	}
+
+	if l, ok := p.For("/main.UrlShortener/ShortenURL"); !ok || l.Burst != 20 {
+		t.Errorf("For(full method) = %+v, %v, want the ShortenURL limit", l, ok)
+	}
+	if l, ok := p.For("/main.UrlShortener/GetURLStats"); !ok || l.Burst != 100 {
+		t.Errorf("For(other method) = %+v, %v, want the * limit", l, ok)
+	}
+	if _, ok := (Policy{"redirect": {Rate: 1, Burst: 1}}).For("ShortenURL"); ok {
+		t.Error("For found a limit in a policy without a match or *")
+	}
+	if l, ok := p.For(Redirect); !ok || l.Burst != 3600 {
+		t.Errorf("For(redirect) = %+v, %v, want the redirect limit", l, ok)
+	}
+	if _, ok := (Policy{"*": {Rate: 1, Burst: 1}}).For(Redirect); ok {
+		t.Error("For applied * to redirects")
+	}
+
+	if p, err := ParsePolicy(""); err != nil || len(p) != 0 {
+		t.Errorf("ParsePolicy(\"\") = %v, %v, want an empty policy", p, err)
+	}
+	for _, spec := range []string{"ShortenURL", "a=10", "a=0/s", "a=-1/s", "a=10/d", "a=10/s:0", "a=10/s:x"} {
+		if _, err := ParsePolicy(spec); err == nil {
+			t.Errorf("ParsePolicy(%q) accepted an invalid entry", spec)
+		}
+	}
+}
+
+func TestMemoryBucket(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+	limit := Limit{Rate: 10, Burst: 3}
+
+	for i := range 3 {
+		if ok, _, _ := m.Allow(ctx, "a", limit); !ok {
+			t.Fatalf("request %d within the burst was refused", i+1)
+		}
+	}
+	ok, wait, err := m.Allow(ctx, "a", limit)
+	if err != nil || ok {
+		t.Fatalf("request past the burst = %v, %v", ok, err)
+	}
+	if wait <= 0 || wait > 100*time.Millisecond {
+		t.Errorf("wait = %v, want up to one refill interval", wait)
+	}
+	if ok, _, _ := m.Allow(ctx, "b", limit); !ok {
+		t.Error("buckets are not separated by key")
+	}
+
+	time.Sleep(wait + 10*time.Millisecond)
+	if ok, _, _ := m.Allow(ctx, "a", limit); !ok {
+		t.Error("bucket did not refill")
+	}
+}
+
+func TestLastForwardedFor(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{nil, ""},
+		{[]string{"203.0.113.7"}, "203.0.113.7"},
+		{[]string{"spoofed, 203.0.113.7"}, "203.0.113.7"},
+		{[]string{"spoofed", "198.51.100.1 , 203.0.113.7 "}, "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		if got := LastForwardedFor(tt.values); got != tt.want {
+			t.Errorf("LastForwardedFor(%q) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest("GET", "/abc", nil)
+	r.RemoteAddr = "192.0.2.1:51234"
+	r.Header.Set("X-Forwarded-For", "spoofed, 203.0.113.7")
+
+	if got := ClientIP(r, false); got != "192.0.2.1" {
+		t.Errorf("untrusted ClientIP = %q, want the peer address", got)
+	}
+	if got := ClientIP(r, true); got != "203.0.113.7" {
+		t.Errorf("trusted ClientIP = %q, want the last forwarded address", got)
+	}
+	r.Header.Del("X-Forwarded-For")
+	if got := ClientIP(r, true); got != "192.0.2.1" {
+		t.Errorf("trusted ClientIP without header = %q, want the peer address", got)
+	}
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// Entry is what the cache remembers about a short id. Click counts are
+// deliberately left out so that stats are always read from the store.
+type Entry struct {
+	OriginalURL string `json:"original_url,omitempty"`
+	ExpireAt    int64  `json:"expire_at,omitempty"`
+	Flagged     bool   `json:"flagged,omitempty"`
+	// Missing marks a negative entry for a short id that does not exist.
+	Missing bool `json:"missing,omitempty"`
+	// Invalidated marks a short id that was just written. Lookups skip the
+	// cache until it expires, and fills cannot replace it.
+	Invalidated bool `json:"invalidated,omitempty"`
+}
+
+// invalidationTTL is how long an invalidated short id stays uncached. A
+// lookup that read the store before the write can only put its stale result
+// into the cache within this window, which Resolve rules out.
+const invalidationTTL = 10 * time.Second
+
+// Cache is a key/value cache for redirect lookups. A miss is reported with
+// ok == false; err is reserved for backend failures.
+type Cache interface {
+	Get(ctx context.Context, key string) (entry Entry, ok bool, err error)
+	Set(ctx context.Context, key string, entry Entry, ttl time.Duration) error
+	// Add is Set for keys that hold no live entry; it reports whether entry
+	// was stored.
+	Add(ctx context.Context, key string, entry Entry, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// CachedStore wraps a repository.URLStore with a read-through cache used by
+// Resolve. Writes go straight to the wrapped store and invalidate the cached
+// entry; every other method is passed through untouched.
+//
+// Invalidations only reach lookups sharing the same Cache. With an
+// in-process cache and several service instances, the other instances keep
+// serving their cached entry for up to its TTL.
+type CachedStore struct {
+	repository.URLStore
+	cache       Cache
+	ttl         time.Duration
+	negativeTTL time.Duration
+}
+
+// NewCachedStore caches positive lookups for at most ttl (and never beyond
+// the link's expire_at) and unknown short ids for negativeTTL. A
+// negativeTTL of zero disables negative caching.
+func NewCachedStore(store repository.URLStore, cache Cache, ttl, negativeTTL time.Duration) *CachedStore {
+	return &CachedStore{URLStore: store, cache: cache, ttl: ttl, negativeTTL: negativeTTL}
+}
+
+// Resolve returns the URL for shortID, consulting the cache first. URLs
+// returned from the cache carry no click count.
+func (c *CachedStore) Resolve(ctx context.Context, shortID string) (*models.URL, error) {
+	entry, ok, err := c.cache.Get(ctx, shortID)
+	if err != nil {
+		// a broken cache must not take redirects down with it
+		log.Printf("cache get %s: %v", shortID, err)
+	}
+	if ok && !entry.Invalidated {
+		if entry.Missing {
+			return nil, repository.ErrNotFound
+		}
+		url := &models.URL{ShortID: shortID, OriginalURL: entry.OriginalURL, ExpireAt: entry.ExpireAt, Flagged: entry.Flagged}
+		if url.Expired(time.Now()) {
+			return nil, repository.ErrExpired
+		}
+		return url, nil
+	}
+
+	// Fills never replace an invalidation, and a lookup that took longer
+	// than invalidationTTL may have read the store before a write whose
+	// invalidation has already expired, so its result is not cached.
+	fill := !ok
+	start := time.Now()
+	url, err := c.URLStore.Get(ctx, shortID)
+	fill = fill && time.Since(start) < invalidationTTL
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		if fill && c.negativeTTL > 0 {
+			c.add(ctx, shortID, Entry{Missing: true}, c.negativeTTL)
+		}
+		return nil, err
+	case err != nil:
+		return nil, err
+	}
+
+	ttl := c.ttl
+	if url.ExpireAt != 0 {
+		if untilExpiry := time.Until(time.Unix(url.ExpireAt, 0)); untilExpiry < ttl {
+			ttl = untilExpiry
+		}
+	}
+	if fill && ttl > 0 {
+		c.add(ctx, shortID, Entry{OriginalURL: url.OriginalURL, ExpireAt: url.ExpireAt, Flagged: url.Flagged}, ttl)
+	}
+	return url, nil
+}
+
+func (c *CachedStore) add(ctx context.Context, shortID string, entry Entry, ttl time.Duration) {
+	if _, err := c.cache.Add(ctx, shortID, entry, ttl); err != nil {
+		log.Printf("cache set %s: %v", shortID, err)
+	}
+}
+
+// invalidate replaces the cached entry with an invalidation marker rather
+// than deleting it, so that lookups already in flight cannot cache what
+// they read before the write.
+func (c *CachedStore) invalidate(ctx context.Context, shortID string) {
+	if err := c.cache.Set(ctx, shortID, Entry{Invalidated: true}, invalidationTTL); err != nil {
+		log.Printf("cache invalidate %s: %v", shortID, err)
+	}
+}
+
+// Create drops any negative entry left over for the new short id.
+func (c *CachedStore) Create(ctx context.Context, url *models.URL) error {
+	if err := c.URLStore.Create(ctx, url); err != nil {
+		return err
+	}
+	c.invalidate(ctx, url.ShortID)
+	return nil
+}
+
+func (c *CachedStore) Update(ctx context.Context, shortID string, upd models.URLUpdate) error {
+	err := c.URLStore.Update(ctx, shortID, upd)
+	c.invalidate(ctx, shortID)
+	return err
+}
+
+func (c *CachedStore) Delete(ctx context.Context, shortID string) error {
+	err := c.URLStore.Delete(ctx, shortID)
+	c.invalidate(ctx, shortID)
+	return err
+}
+
+// Nop is a Cache that never stores anything, for turning caching off.
+type Nop struct{}
+
+func (Nop) Get(ctx context.Context, key string) (Entry, bool, error) { return Entry{}, false, nil }
+
+func (Nop) Set(ctx context.Context, key string, entry Entry, ttl time.Duration) error { return nil }
+
+func (Nop) Add(ctx context.Context, key string, entry Entry, ttl time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (Nop) Delete(ctx context.Context, key string) error { return nil }
@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository/db"
+)
+
+// pausingStore lets a test run writes between the moment Get has read a
+// record and the moment it returns it.
+type pausingStore struct {
+	repository.URLStore
+	read    chan struct{}
+	release chan struct{}
+}
+
+func (p *pausingStore) Get(ctx context.Context, shortID string) (*models.URL, error) {
+	url, err := p.URLStore.Get(ctx, shortID)
+	if p.read != nil {
+		p.read <- struct{}{}
+		<-p.release
+	}
+	return url, err
+}
+
+func newTestStore(t *testing.T, urls ...*models.URL) *db.MemoryStore {
+	t.Helper()
+	store := db.NewMemoryStore()
+	for _, u := range urls {
+		if err := store.Create(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func TestResolveServesFromCache(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, &models.URL{ShortID: "abc", OriginalURL: "https://example.com/a"})
+	c := NewCachedStore(store, NewLRU(10), time.Minute, time.Minute)
+
+	if _, err := c.Resolve(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	// bypass the wrapper so the cache does not learn about the change
+	newURL := "https://example.com/b"
+	if err := store.Update(ctx, "abc", models.URLUpdate{OriginalURL: &newURL}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Resolve(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OriginalURL != "https://example.com/a" {
+		t.Errorf("OriginalURL = %q, want the cached destination", got.OriginalURL)
+	}
+
+	if _, err := c.Resolve(ctx, "nope"); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Resolve(unknown) = %v, want ErrNotFound", err)
+	}
+	if entry, ok, _ := c.cache.Get(ctx, "nope"); !ok || !entry.Missing {
+		t.Errorf("unknown short id was not cached as missing: %+v, %v", entry, ok)
+	}
+}
+
+func TestUpdateInvalidates(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, &models.URL{ShortID: "abc", OriginalURL: "https://example.com/a"})
+	c := NewCachedStore(store, NewLRU(10), time.Minute, time.Minute)
+
+	if _, err := c.Resolve(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	newURL := "https://example.com/b"
+	if err := c.Update(ctx, "abc", models.URLUpdate{OriginalURL: &newURL}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Resolve(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OriginalURL != newURL {
+		t.Errorf("OriginalURL = %q, want %q", got.OriginalURL, newURL)
+	}
+
+	if err := c.Delete(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Resolve(ctx, "abc"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Resolve after Delete = %v, want ErrNotFound", err)
+	}
+}
+
+func TestResolveRacingUpdateDoesNotCacheStaleValue(t *testing.T) {
+	ctx := context.Background()
+	store := &pausingStore{
+		URLStore: newTestStore(t, &models.URL{ShortID: "abc", OriginalURL: "https://example.com/old"}),
+		read:     make(chan struct{}),
+		release:  make(chan struct{}),
+	}
+	c := NewCachedStore(store, NewLRU(10), time.Minute, time.Minute)
+
+	done := make(chan error)
+	go func() {
+		_, err := c.Resolve(ctx, "abc")
+		done <- err
+	}()
+
+	<-store.read // the lookup holds the old destination
+	newURL := "https://example.com/new"
+	if err := c.Update(ctx, "abc", models.URLUpdate{OriginalURL: &newURL}); err != nil {
+		t.Fatal(err)
+	}
+	close(store.release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	store.read = nil
+	got, err := c.Resolve(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OriginalURL != newURL {
+		t.Errorf("OriginalURL = %q after the update, want %q", got.OriginalURL, newURL)
+	}
+}
+
+func TestLRUAdd(t *testing.T) {
+	ctx := context.Background()
+	l := NewLRU(2)
+
+	if ok, _ := l.Add(ctx, "a", Entry{OriginalURL: "1"}, time.Minute); !ok {
+		t.Fatal("Add to an empty cache was refused")
+	}
+	if ok, _ := l.Add(ctx, "a", Entry{OriginalURL: "2"}, time.Minute); ok {
+		t.Error("Add replaced a live entry")
+	}
+	if entry, _, _ := l.Get(ctx, "a"); entry.OriginalURL != "1" {
+		t.Errorf("entry = %+v, want the first one", entry)
+	}
+
+	l.Set(ctx, "b", Entry{OriginalURL: "b"}, -time.Second)
+	if ok, _ := l.Add(ctx, "b", Entry{OriginalURL: "b2"}, time.Minute); !ok {
+		t.Error("Add over an expired entry was refused")
+	}
+
+	l.Set(ctx, "c", Entry{}, time.Minute)
+	if _, ok, _ := l.Get(ctx, "a"); ok {
+		t.Error("least recently used entry was not evicted")
+	}
+}
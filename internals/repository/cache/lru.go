@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// LRU is a size-bounded in-process Cache. Entries are evicted when they
+// expire or when they are the least recently used and space is needed.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front is most recently used
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key      string
+	entry    Entry
+	deadline time.Time
+}
+
+// NewLRU returns an LRU holding at most capacity entries.
+func NewLRU(capacity int) *LRU {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRU{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}
+}
+
+func (l *LRU) Get(ctx context.Context, key string) (Entry, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return Entry{}, false, nil
+	}
+	item := el.Value.(*lruItem)
+	if time.Now().After(item.deadline) {
+		l.remove(el)
+		return Entry{}, false, nil
+	}
+	l.order.MoveToFront(el)
+	return item.entry, true, nil
+}
+
+func (l *LRU) Set(ctx context.Context, key string, entry Entry, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.set(key, entry, time.Now().Add(ttl))
+	return nil
+}
+
+func (l *LRU) Add(ctx context.Context, key string, entry Entry, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if el, ok := l.items[key]; ok && !now.After(el.Value.(*lruItem).deadline) {
+		return false, nil
+	}
+	l.set(key, entry, now.Add(ttl))
+	return true, nil
+}
+
+func (l *LRU) set(key string, entry Entry, deadline time.Time) {
+	if el, ok := l.items[key]; ok {
+		item := el.Value.(*lruItem)
+		item.entry, item.deadline = entry, deadline
+		l.order.MoveToFront(el)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{key: key, entry: entry, deadline: deadline})
+	for l.order.Len() > l.capacity {
+		l.remove(l.order.Back())
+	}
+}
+
+func (l *LRU) Delete(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		l.remove(el)
+	}
+	return nil
+}
+
+func (l *LRU) remove(el *list.Element) {
+	l.order.Remove(el)
+	delete(l.items, el.Value.(*lruItem).key)
+}
@@ -0,0 +1,808 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	boltMetaBucket = []byte("meta")
+	boltURLsBucket = []byte("urls")
+	boltKeysBucket = []byte("api_keys")
+	// boltOwnerBucket and boltWorkspaceBucket index urls by owner and by
+	// workspace, see boltIndexKey.
+	boltOwnerBucket     = []byte("urls_by_owner")
+	boltWorkspaceBucket = []byte("urls_by_workspace")
+	// boltWorkspacesBucket holds workspace records keyed by id, and
+	// boltMembersBucket memberships keyed by workspace_id + "\x00" + user_id.
+	boltWorkspacesBucket = []byte("workspaces")
+	boltMembersBucket    = []byte("workspace_members")
+	// boltCreationsBucket holds each owner's latest dailyCount.
+	boltCreationsBucket = []byte("daily_creations")
+	// boltDomainsBucket holds custom domain records keyed by name.
+	boltDomainsBucket = []byte("domains")
+	boltSchemaKey     = []byte("schema_version")
+)
+
+// boltMigrations are applied in order on Open. The schema version stored in
+// the meta bucket is the number of migrations already applied, so new
+// migrations must only ever be appended.
+var boltMigrations = []func(tx *bolt.Tx) error{
+	// 1: bucket of url records keyed by short_id
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltURLsBucket)
+		return err
+	},
+	// 2: bucket of api key records keyed by key id
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltKeysBucket)
+		return err
+	},
+	// 3: owner index over the urls bucket
+	func(tx *bolt.Tx) error {
+		index, err := tx.CreateBucketIfNotExists(boltOwnerBucket)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(boltURLsBucket).ForEach(func(k, v []byte) error {
+			var rec boltRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return fmt.Errorf("failed to decode record %s: %w", k, err)
+			}
+			if rec.OwnerID == "" {
+				return nil
+			}
+			return index.Put(boltIndexKey(rec.OwnerID, rec.ShortID), nil)
+		})
+	},
+	// 4: workspaces, their members and the workspace index over urls
+	func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{boltWorkspacesBucket, boltMembersBucket, boltWorkspaceBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	// 5: daily link creation counts keyed by owner
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltCreationsBucket)
+		return err
+	},
+	// 6: custom domains keyed by name
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltDomainsBucket)
+		return err
+	},
+}
+
+// BoltStore is a repository.URLStore persisted to a single local bbolt file,
+// for deployments that do not run on AWS. Expiry is handled the same way as
+// MemoryStore.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+var (
+	_ repository.URLStore       = (*BoltStore)(nil)
+	_ repository.APIKeyStore    = (*BoltStore)(nil)
+	_ repository.WorkspaceStore = (*BoltStore)(nil)
+	_ repository.DomainStore    = (*BoltStore)(nil)
+	_ repository.QuotaStore     = (*BoltStore)(nil)
+)
+
+// boltRecord is the JSON encoding of a URL inside the urls bucket.
+type boltRecord struct {
+	ShortID     string    `json:"short_id"`
+	OriginalURL string    `json:"original_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpireAt    int64     `json:"expire_at"`
+	Clicks      int64     `json:"clicks"`
+	Flagged     bool      `json:"flagged,omitempty"`
+	OwnerID     string    `json:"owner_id,omitempty"`
+	WorkspaceID string    `json:"workspace_id,omitempty"`
+}
+
+func (r boltRecord) toModel() *models.URL {
+	return &models.URL{
+		ShortID:     r.ShortID,
+		OriginalURL: r.OriginalURL,
+		CreatedAt:   r.CreatedAt,
+		ExpireAt:    r.ExpireAt,
+		Clicks:      r.Clicks,
+		Flagged:     r.Flagged,
+		OwnerID:     r.OwnerID,
+		WorkspaceID: r.WorkspaceID,
+	}
+}
+
+// NewBoltStore opens (or creates) the database file at path and brings its
+// schema up to date.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database: %w", err)
+	}
+	if err := db.Update(migrateBolt); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate bolt database: %w", err)
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func migrateBolt(tx *bolt.Tx) error {
+	meta, err := tx.CreateBucketIfNotExists(boltMetaBucket)
+	if err != nil {
+		return err
+	}
+
+	var version uint64
+	if v := meta.Get(boltSchemaKey); v != nil {
+		version = binary.BigEndian.Uint64(v)
+	}
+	if version > uint64(len(boltMigrations)) {
+		return fmt.Errorf("database schema version %d is newer than this binary supports (%d)", version, len(boltMigrations))
+	}
+
+	for ; version < uint64(len(boltMigrations)); version++ {
+		if err := boltMigrations[version](tx); err != nil {
+			return fmt.Errorf("migration %d: %w", version+1, err)
+		}
+	}
+	return meta.Put(boltSchemaKey, binary.BigEndian.AppendUint64(nil, version))
+}
+
+// Close releases the database file lock.
+func (b *BoltStore) Close() error {
+	return b.db.Close()
+}
+
+func getBoltRecord(bucket *bolt.Bucket, shortID string) (*boltRecord, error) {
+	v := bucket.Get([]byte(shortID))
+	if v == nil {
+		return nil, repository.ErrNotFound
+	}
+	var rec boltRecord
+	if err := json.Unmarshal(v, &rec); err != nil {
+		return nil, fmt.Errorf("failed to decode record: %w", err)
+	}
+	return &rec, nil
+}
+
+func putBoltRecord(bucket *bolt.Bucket, rec *boltRecord) error {
+	v, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	return bucket.Put([]byte(rec.ShortID), v)
+}
+
+// boltIndexKey is the key of shortID in an index bucket: the indexed value,
+// a NUL byte and the short id. Index entries have empty values.
+func boltIndexKey(value, shortID string) []byte {
+	return []byte(value + "\x00" + shortID)
+}
+
+// reindexBolt moves shortID from oldValue to newValue in index. Empty values
+// are not indexed.
+func reindexBolt(index *bolt.Bucket, shortID, oldValue, newValue string) error {
+	if oldValue == newValue {
+		return nil
+	}
+	if oldValue != "" {
+		if err := index.Delete(boltIndexKey(oldValue, shortID)); err != nil {
+			return err
+		}
+	}
+	if newValue != "" {
+		return index.Put(boltIndexKey(newValue, shortID), nil)
+	}
+	return nil
+}
+
+func (b *BoltStore) Create(ctx context.Context, url *models.URL) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		// an expired record may be replaced; its index entries go with it.
+		// A record that cannot be decoded is never overwritten.
+		var old boltRecord
+		existing, err := getBoltRecord(bucket, url.ShortID)
+		switch {
+		case err == nil:
+			if !existing.toModel().Expired(time.Now()) {
+				return repository.ErrAlreadyExists
+			}
+			old = *existing
+		case !errors.Is(err, repository.ErrNotFound):
+			return err
+		}
+		if err := reindexBolt(tx.Bucket(boltOwnerBucket), url.ShortID, old.OwnerID, url.OwnerID); err != nil {
+			return err
+		}
+		if err := reindexBolt(tx.Bucket(boltWorkspaceBucket), url.ShortID, old.WorkspaceID, url.WorkspaceID); err != nil {
+			return err
+		}
+		return putBoltRecord(bucket, &boltRecord{
+			ShortID:     url.ShortID,
+			OriginalURL: url.OriginalURL,
+			CreatedAt:   url.CreatedAt,
+			ExpireAt:    url.ExpireAt,
+			Clicks:      url.Clicks,
+			Flagged:     url.Flagged,
+			OwnerID:     url.OwnerID,
+			WorkspaceID: url.WorkspaceID,
+		})
+	})
+}
+
+func (b *BoltStore) Get(ctx context.Context, shortID string) (*models.URL, error) {
+	var url *models.URL
+	err := b.db.View(func(tx *bolt.Tx) error {
+		rec, err := getBoltRecord(tx.Bucket(boltURLsBucket), shortID)
+		if err != nil {
+			return err
+		}
+		url = rec.toModel()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if url.Expired(time.Now()) {
+		return url, repository.ErrExpired
+	}
+	return url, nil
+}
+
+func (b *BoltStore) Update(ctx context.Context, shortID string, upd models.URLUpdate) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		rec, err := getBoltRecord(bucket, shortID)
+		if err != nil {
+			return err
+		}
+		if upd.OriginalURL != nil {
+			rec.OriginalURL = *upd.OriginalURL
+		}
+		if upd.ExpireAt != nil {
+			rec.ExpireAt = *upd.ExpireAt
+		}
+		if upd.Flagged != nil {
+			rec.Flagged = *upd.Flagged
+		}
+		if upd.WorkspaceID != nil {
+			if err := reindexBolt(tx.Bucket(boltWorkspaceBucket), shortID, rec.WorkspaceID, *upd.WorkspaceID); err != nil {
+				return err
+			}
+			rec.WorkspaceID = *upd.WorkspaceID
+		}
+		return putBoltRecord(bucket, rec)
+	})
+}
+
+func (b *BoltStore) Delete(ctx context.Context, shortID string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		rec, err := getBoltRecord(bucket, shortID)
+		if err != nil {
+			return err
+		}
+		if err := reindexBolt(tx.Bucket(boltOwnerBucket), shortID, rec.OwnerID, ""); err != nil {
+			return err
+		}
+		if err := reindexBolt(tx.Bucket(boltWorkspaceBucket), shortID, rec.WorkspaceID, ""); err != nil {
+			return err
+		}
+		return bucket.Delete([]byte(shortID))
+	})
+}
+
+// List walks the urls bucket in key order. The cursor is the short id of
+// the last URL in the previous page.
+func (b *BoltStore) List(ctx context.Context, limit int32, cursor string) ([]*models.URL, string, error) {
+	var (
+		urls []*models.URL
+		next string
+	)
+	now := time.Now()
+	err := b.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(boltURLsBucket).Cursor()
+
+		k, v := c.First()
+		if cursor != "" {
+			k, v = c.Seek([]byte(cursor))
+			if bytes.Equal(k, []byte(cursor)) {
+				k, v = c.Next()
+			}
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			var rec boltRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return fmt.Errorf("failed to decode record %s: %w", k, err)
+			}
+			url := rec.toModel()
+			if url.Expired(now) {
+				continue
+			}
+			if limit > 0 && len(urls) == int(limit) {
+				next = urls[len(urls)-1].ShortID
+				break
+			}
+			urls = append(urls, url)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return urls, next, nil
+}
+
+// ListByOwner walks the owner index in short id order and loads each URL
+// from the urls bucket.
+func (b *BoltStore) ListByOwner(ctx context.Context, ownerID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return b.listIndex(boltOwnerBucket, ownerID, limit, cursor)
+}
+
+// ListByWorkspace does the same over the workspace index.
+func (b *BoltStore) ListByWorkspace(ctx context.Context, workspaceID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return b.listIndex(boltWorkspaceBucket, workspaceID, limit, cursor)
+}
+
+func (b *BoltStore) listIndex(indexBucket []byte, value string, limit int32, cursor string) ([]*models.URL, string, error) {
+	var (
+		urls []*models.URL
+		next string
+	)
+	now := time.Now()
+	prefix := boltIndexKey(value, "")
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		c := tx.Bucket(indexBucket).Cursor()
+
+		k, _ := c.Seek(prefix)
+		if cursor != "" {
+			start := boltIndexKey(value, cursor)
+			k, _ = c.Seek(start)
+			if bytes.Equal(k, start) {
+				k, _ = c.Next()
+			}
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			rec, err := getBoltRecord(bucket, string(k[len(prefix):]))
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			url := rec.toModel()
+			if url.Expired(now) {
+				continue
+			}
+			if limit > 0 && len(urls) == int(limit) {
+				next = urls[len(urls)-1].ShortID
+				break
+			}
+			urls = append(urls, url)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return urls, next, nil
+}
+
+// CountByOwner walks the owner index, counting the URLs that have not
+// expired.
+func (b *BoltStore) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	var n int64
+	now := time.Now()
+	prefix := boltIndexKey(ownerID, "")
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		c := tx.Bucket(boltOwnerBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			rec, err := getBoltRecord(bucket, string(k[len(prefix):]))
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !rec.toModel().Expired(now) {
+				n++
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
+func (b *BoltStore) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	var clicks int64
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltURLsBucket)
+		rec, err := getBoltRecord(bucket, shortID)
+		if err != nil {
+			return err
+		}
+		if rec.toModel().Expired(time.Now()) {
+			return repository.ErrExpired
+		}
+		rec.Clicks += n
+		clicks = rec.Clicks
+		return putBoltRecord(bucket, rec)
+	})
+	return clicks, err
+}
+
+func (b *BoltStore) Ping(ctx context.Context) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(boltURLsBucket) == nil {
+			return fmt.Errorf("urls bucket missing")
+		}
+		return nil
+	})
+}
+
+// NextSequence returns the next value of a counter persisted in the meta
+// bucket.
+func (b *BoltStore) NextSequence(ctx context.Context) (uint64, error) {
+	var n uint64
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		n, err = tx.Bucket(boltMetaBucket).NextSequence()
+		return err
+	})
+	return n, err
+}
+
+// boltKeyRecord is the JSON encoding of an API key inside the api_keys
+// bucket.
+type boltKeyRecord struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name,omitempty"`
+	Hash      string    `json:"hash"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	RevokedAt int64     `json:"revoked_at,omitempty"`
+}
+
+func getBoltKeyRecord(bucket *bolt.Bucket, id string) (*boltKeyRecord, error) {
+	v := bucket.Get([]byte(id))
+	if v == nil {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+	var rec boltKeyRecord
+	if err := json.Unmarshal(v, &rec); err != nil {
+		return nil, fmt.Errorf("failed to decode api key: %w", err)
+	}
+	return &rec, nil
+}
+
+func putBoltKeyRecord(bucket *bolt.Bucket, rec *boltKeyRecord) error {
+	v, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode api key: %w", err)
+	}
+	return bucket.Put([]byte(rec.ID), v)
+}
+
+func (b *BoltStore) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltKeysBucket)
+		if bucket.Get([]byte(key.ID)) != nil {
+			return repository.ErrAlreadyExists
+		}
+		return putBoltKeyRecord(bucket, &boltKeyRecord{
+			ID:        key.ID,
+			Name:      key.Name,
+			Hash:      key.Hash,
+			Scopes:    key.Scopes,
+			CreatedAt: key.CreatedAt,
+			RevokedAt: key.RevokedAt,
+		})
+	})
+}
+
+func (b *BoltStore) GetAPIKey(ctx context.Context, id string) (*models.APIKey, error) {
+	var key *models.APIKey
+	err := b.db.View(func(tx *bolt.Tx) error {
+		rec, err := getBoltKeyRecord(tx.Bucket(boltKeysBucket), id)
+		if err != nil {
+			return err
+		}
+		key = &models.APIKey{
+			ID:        rec.ID,
+			Name:      rec.Name,
+			Hash:      rec.Hash,
+			Scopes:    rec.Scopes,
+			CreatedAt: rec.CreatedAt,
+			RevokedAt: rec.RevokedAt,
+		}
+		return nil
+	})
+	return key, err
+}
+
+func (b *BoltStore) RevokeAPIKey(ctx context.Context, id string, at int64) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltKeysBucket)
+		rec, err := getBoltKeyRecord(bucket, id)
+		if err != nil {
+			return err
+		}
+		if rec.RevokedAt != 0 {
+			return nil
+		}
+		rec.RevokedAt = at
+		return putBoltKeyRecord(bucket, rec)
+	})
+}
+
+// boltWorkspaceRecord is the JSON encoding of a workspace inside the
+// workspaces bucket.
+type boltWorkspaceRecord struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by,omitempty"`
+}
+
+// boltMemberRecord is the JSON encoding of a membership inside the
+// workspace_members bucket.
+type boltMemberRecord struct {
+	WorkspaceID string    `json:"workspace_id"`
+	UserID      string    `json:"user_id"`
+	Role        string    `json:"role"`
+	AddedAt     time.Time `json:"added_at"`
+}
+
+func (r boltMemberRecord) toModel() *models.Member {
+	return &models.Member{
+		WorkspaceID: r.WorkspaceID,
+		UserID:      r.UserID,
+		Role:        models.Role(r.Role),
+		AddedAt:     r.AddedAt,
+	}
+}
+
+func putBoltMember(bucket *bolt.Bucket, m *models.Member) error {
+	v, err := json.Marshal(boltMemberRecord{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        string(m.Role),
+		AddedAt:     m.AddedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode member: %w", err)
+	}
+	return bucket.Put(boltIndexKey(m.WorkspaceID, m.UserID), v)
+}
+
+func (b *BoltStore) CreateWorkspace(ctx context.Context, ws *models.Workspace, admin *models.Member) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltWorkspacesBucket)
+		if bucket.Get([]byte(ws.ID)) != nil {
+			return repository.ErrAlreadyExists
+		}
+		v, err := json.Marshal(boltWorkspaceRecord{
+			ID:        ws.ID,
+			Name:      ws.Name,
+			CreatedAt: ws.CreatedAt,
+			CreatedBy: ws.CreatedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode workspace: %w", err)
+		}
+		if err := bucket.Put([]byte(ws.ID), v); err != nil {
+			return err
+		}
+		return putBoltMember(tx.Bucket(boltMembersBucket), admin)
+	})
+}
+
+func (b *BoltStore) GetWorkspace(ctx context.Context, id string) (*models.Workspace, error) {
+	var rec boltWorkspaceRecord
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(boltWorkspacesBucket).Get([]byte(id))
+		if v == nil {
+			return repository.ErrWorkspaceNotFound
+		}
+		if err := json.Unmarshal(v, &rec); err != nil {
+			return fmt.Errorf("failed to decode workspace: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.Workspace{
+		ID:        rec.ID,
+		Name:      rec.Name,
+		CreatedAt: rec.CreatedAt,
+		CreatedBy: rec.CreatedBy,
+	}, nil
+}
+
+func (b *BoltStore) PutMember(ctx context.Context, m *models.Member) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(boltWorkspacesBucket).Get([]byte(m.WorkspaceID)) == nil {
+			return repository.ErrWorkspaceNotFound
+		}
+		return putBoltMember(tx.Bucket(boltMembersBucket), m)
+	})
+}
+
+func (b *BoltStore) GetMember(ctx context.Context, workspaceID, userID string) (*models.Member, error) {
+	var rec boltMemberRecord
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(boltMembersBucket).Get(boltIndexKey(workspaceID, userID))
+		if v == nil {
+			return repository.ErrMemberNotFound
+		}
+		if err := json.Unmarshal(v, &rec); err != nil {
+			return fmt.Errorf("failed to decode member: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rec.toModel(), nil
+}
+
+func (b *BoltStore) DeleteMember(ctx context.Context, workspaceID, userID string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltMembersBucket)
+		key := boltIndexKey(workspaceID, userID)
+		if bucket.Get(key) == nil {
+			return repository.ErrMemberNotFound
+		}
+		return bucket.Delete(key)
+	})
+}
+
+// boltDomainRecord is the JSON encoding of a custom domain inside the
+// domains bucket.
+type boltDomainRecord struct {
+	Name              string    `json:"name"`
+	VerificationToken string    `json:"verification_token"`
+	CreatedAt         time.Time `json:"created_at"`
+	CreatedBy         string    `json:"created_by,omitempty"`
+	WorkspaceID       string    `json:"workspace_id,omitempty"`
+	VerifiedAt        int64     `json:"verified_at,omitempty"`
+}
+
+func (r boltDomainRecord) toModel() *models.Domain {
+	return &models.Domain{
+		Name:              r.Name,
+		VerificationToken: r.VerificationToken,
+		CreatedAt:         r.CreatedAt,
+		CreatedBy:         r.CreatedBy,
+		WorkspaceID:       r.WorkspaceID,
+		VerifiedAt:        r.VerifiedAt,
+	}
+}
+
+func getBoltDomainRecord(bucket *bolt.Bucket, name string) (*boltDomainRecord, error) {
+	v := bucket.Get([]byte(name))
+	if v == nil {
+		return nil, repository.ErrDomainNotFound
+	}
+	var rec boltDomainRecord
+	if err := json.Unmarshal(v, &rec); err != nil {
+		return nil, fmt.Errorf("failed to decode domain: %w", err)
+	}
+	return &rec, nil
+}
+
+func putBoltDomainRecord(bucket *bolt.Bucket, rec *boltDomainRecord) error {
+	v, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode domain: %w", err)
+	}
+	return bucket.Put([]byte(rec.Name), v)
+}
+
+func (b *BoltStore) CreateDomain(ctx context.Context, d *models.Domain) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltDomainsBucket)
+		if bucket.Get([]byte(d.Name)) != nil {
+			return repository.ErrAlreadyExists
+		}
+		return putBoltDomainRecord(bucket, &boltDomainRecord{
+			Name:              d.Name,
+			VerificationToken: d.VerificationToken,
+			CreatedAt:         d.CreatedAt,
+			CreatedBy:         d.CreatedBy,
+			WorkspaceID:       d.WorkspaceID,
+			VerifiedAt:        d.VerifiedAt,
+		})
+	})
+}
+
+func (b *BoltStore) GetDomain(ctx context.Context, name string) (*models.Domain, error) {
+	var d *models.Domain
+	err := b.db.View(func(tx *bolt.Tx) error {
+		rec, err := getBoltDomainRecord(tx.Bucket(boltDomainsBucket), name)
+		if err != nil {
+			return err
+		}
+		d = rec.toModel()
+		return nil
+	})
+	return d, err
+}
+
+func (b *BoltStore) VerifyDomain(ctx context.Context, name string, at int64) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltDomainsBucket)
+		rec, err := getBoltDomainRecord(bucket, name)
+		if err != nil {
+			return err
+		}
+		if rec.VerifiedAt != 0 {
+			return nil
+		}
+		rec.VerifiedAt = at
+		return putBoltDomainRecord(bucket, rec)
+	})
+}
+
+func getBoltDailyCount(bucket *bolt.Bucket, ownerID string) (dailyCount, error) {
+	var c dailyCount
+	v := bucket.Get([]byte(ownerID))
+	if v == nil {
+		return c, nil
+	}
+	if err := json.Unmarshal(v, &c); err != nil {
+		return c, fmt.Errorf("failed to decode daily count: %w", err)
+	}
+	return c, nil
+}
+
+func (b *BoltStore) DailyCreations(ctx context.Context, ownerID, day string) (int64, error) {
+	var c dailyCount
+	err := b.db.View(func(tx *bolt.Tx) error {
+		var err error
+		c, err = getBoltDailyCount(tx.Bucket(boltCreationsBucket), ownerID)
+		return err
+	})
+	return c.on(day), err
+}
+
+func (b *BoltStore) AddDailyCreation(ctx context.Context, ownerID, day string, delta, limit int64) (int64, error) {
+	var n int64
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltCreationsBucket)
+		c, err := getBoltDailyCount(bucket, ownerID)
+		if err != nil {
+			return err
+		}
+		if c, err = c.add(day, delta, limit); err != nil {
+			n = c.on(day)
+			return err
+		}
+		v, err := json.Marshal(c)
+		if err != nil {
+			return err
+		}
+		n = c.on(day)
+		return bucket.Put([]byte(ownerID), v)
+	})
+	return n, err
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	bolt "go.etcd.io/bbolt"
+)
+
+func newTestBoltStore(t *testing.T) *BoltStore {
+	t.Helper()
+	store, err := NewBoltStore(filepath.Join(t.TempDir(), "urls.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestBoltCreateReplacesOnlyExpiredRecords(t *testing.T) {
+	ctx := context.Background()
+	store := newTestBoltStore(t)
+
+	live := &models.URL{ShortID: "abc", OriginalURL: "https://example.com/a", CreatedAt: time.Now()}
+	if err := store.Create(ctx, live); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Create(ctx, live); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Fatalf("Create over a live record = %v, want ErrAlreadyExists", err)
+	}
+
+	expired := &models.URL{ShortID: "old", OriginalURL: "https://example.com/b", ExpireAt: time.Now().Add(-time.Hour).Unix()}
+	if err := store.Create(ctx, expired); err != nil {
+		t.Fatal(err)
+	}
+	replacement := &models.URL{ShortID: "old", OriginalURL: "https://example.com/c"}
+	if err := store.Create(ctx, replacement); err != nil {
+		t.Fatalf("Create over an expired record = %v", err)
+	}
+	got, err := store.Get(ctx, "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OriginalURL != replacement.OriginalURL {
+		t.Errorf("OriginalURL = %q, want %q", got.OriginalURL, replacement.OriginalURL)
+	}
+}
+
+func TestBoltCreateKeepsUndecodableRecords(t *testing.T) {
+	ctx := context.Background()
+	store := newTestBoltStore(t)
+
+	corrupt := []byte("{not json")
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltURLsBucket).Put([]byte("bad"), corrupt)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Create(ctx, &models.URL{ShortID: "bad", OriginalURL: "https://example.com"}); err == nil {
+		t.Fatal("Create overwrote a record it could not decode")
+	}
+	err = store.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(boltURLsBucket).Get([]byte("bad")); string(v) != string(corrupt) {
+			t.Errorf("stored record = %q, want it untouched", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
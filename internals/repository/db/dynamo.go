@@ -2,10 +2,16 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
@@ -15,10 +21,69 @@ import (
 	"github.com/joho/godotenv"
 )
 
+const (
+	urlsTable     = "Urls"
+	countersTable = "Counters"
+	apiKeysTable  = "ApiKeys"
+	// ownerIndex and workspaceIndex are Urls global secondary indexes with
+	// partition key owner_id or workspace_id and sort key short_id.
+	ownerIndex     = "owner_id-index"
+	workspaceIndex = "workspace_id-index"
+	// workspacesTable is keyed by workspace_id, membersTable by
+	// workspace_id and user_id.
+	workspacesTable = "Workspaces"
+	membersTable    = "WorkspaceMembers"
+	// domainsTable is keyed by domain.
+	domainsTable = "Domains"
+	// shortIDCounter is the Counters item backing NextSequence.
+	shortIDCounter = "short_id"
+	// creationsCounterPrefix prefixes the owner id in the name of the
+	// Counters item holding an owner's daily creation count.
+	creationsCounterPrefix = "created#"
+)
+
+// DynamoClient is the DynamoDB backed repository.URLStore.
 type DynamoClient struct {
 	DB *dynamodb.Client
 }
 
+var (
+	_ repository.URLStore          = (*DynamoClient)(nil)
+	_ repository.APIKeyStore       = (*DynamoClient)(nil)
+	_ repository.WorkspaceStore    = (*DynamoClient)(nil)
+	_ repository.DomainStore       = (*DynamoClient)(nil)
+	_ repository.LegacyExpiryStore = (*DynamoClient)(nil)
+	_ repository.QuotaStore        = (*DynamoClient)(nil)
+)
+
+// urlItem mirrors the layout of an item in the Urls table.
+type urlItem struct {
+	ShortID     string `dynamodbav:"short_id"`
+	OriginalURL string `dynamodbav:"original_url"`
+	CreatedAt   string `dynamodbav:"created_at"`
+	ExpireAt    int64  `dynamodbav:"expire_at,omitempty"` // absent when the link never expires
+	Clicks      int64  `dynamodbav:"clicks"`
+	Flagged     bool   `dynamodbav:"flagged,omitempty"`
+	// OwnerID and WorkspaceID are absent when empty, which keeps the item
+	// out of the matching index.
+	OwnerID     string `dynamodbav:"owner_id,omitempty"`
+	WorkspaceID string `dynamodbav:"workspace_id,omitempty"`
+}
+
+func (i urlItem) toModel() *models.URL {
+	createdAt, _ := time.Parse(time.RFC3339, i.CreatedAt)
+	return &models.URL{
+		ShortID:     i.ShortID,
+		OriginalURL: i.OriginalURL,
+		CreatedAt:   createdAt,
+		ExpireAt:    i.ExpireAt,
+		Clicks:      i.Clicks,
+		Flagged:     i.Flagged,
+		OwnerID:     i.OwnerID,
+		WorkspaceID: i.WorkspaceID,
+	}
+}
+
 // Load credentials from .env manually
 func NewDynamoClient() (*DynamoClient, error) {
 	// Load .env file
@@ -57,47 +122,922 @@ func NewDynamoClient() (*DynamoClient, error) {
 	return &DynamoClient{DB: db}, nil
 }
 
-// GetLongURL looks up a short key in the Urls table and returns the original URL.
-func (c *DynamoClient) GetLongURL(ctx context.Context, shortKey string) (string, error) {
+func shortIDKey(shortID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"short_id": &types.AttributeValueMemberS{Value: shortID},
+	}
+}
+
+// isConditionFailed reports whether err is a failed ConditionExpression.
+func isConditionFailed(err error) bool {
+	var ccf *types.ConditionalCheckFailedException
+	return errors.As(err, &ccf)
+}
+
+// expiryValues binds :zero and :now for expressions that test expire_at.
+func expiryValues(now time.Time) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		":zero": &types.AttributeValueMemberN{Value: "0"},
+		":now":  &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+	}
+}
+
+// Create inserts a new item, refusing to overwrite an existing short_id.
+func (c *DynamoClient) Create(ctx context.Context, url *models.URL) error {
+	item, err := attributevalue.MarshalMap(urlItem{
+		ShortID:     url.ShortID,
+		OriginalURL: url.OriginalURL,
+		CreatedAt:   url.CreatedAt.Format(time.RFC3339),
+		ExpireAt:    url.ExpireAt,
+		Clicks:      url.Clicks,
+		Flagged:     url.Flagged,
+		OwnerID:     url.OwnerID,
+		WorkspaceID: url.WorkspaceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal item: %w", err)
+	}
+
+	// An expired item may still be waiting for TTL deletion; its short_id
+	// is free to reuse.
+	_, err = c.DB.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(urlsTable),
+		Item:                      item,
+		ConditionExpression:       aws.String("attribute_not_exists(short_id) OR (expire_at <> :zero AND expire_at <= :now)"),
+		ExpressionAttributeValues: expiryValues(time.Now()),
+	})
+	if isConditionFailed(err) {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert item: %w", err)
+	}
+	return nil
+}
+
+// Get looks up a short key in the Urls table.
+func (c *DynamoClient) Get(ctx context.Context, shortID string) (*models.URL, error) {
 	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String("Urls"),
-		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: shortKey},
-		},
+		TableName: aws.String(urlsTable),
+		Key:       shortIDKey(shortID),
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to get item: %w", err)
+		return nil, fmt.Errorf("failed to get item: %w", err)
 	}
 	if out.Item == nil {
-		return "", fmt.Errorf("short_id not found")
+		return nil, repository.ErrNotFound
 	}
 
-	var data struct {
-		OriginalUrl string `dynamodbav:"original_url"`
+	var item urlItem
+	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal item: %w", err)
 	}
-	if err := attributevalue.UnmarshalMap(out.Item, &data); err != nil {
-		return "", fmt.Errorf("failed to unmarshal item: %w", err)
+
+	// TTL deletion can lag by days, so expiry is enforced on read.
+	url := item.toModel()
+	if url.Expired(time.Now()) {
+		return url, repository.ErrExpired
+	}
+	return url, nil
+}
+
+// ClearLegacyExpiry scans the table for links whose expire_at equals their
+// created_at, as stored by versions that ignored expiry for links created
+// without one, and removes the attribute. Each update is conditional on the
+// old value, so concurrent runs and links updated meanwhile are safe.
+func (c *DynamoClient) ClearLegacyExpiry(ctx context.Context) (int64, error) {
+	paginator := dynamodb.NewScanPaginator(c.DB, &dynamodb.ScanInput{
+		TableName:                aws.String(urlsTable),
+		ProjectionExpression:     aws.String("short_id, created_at, #exp"),
+		FilterExpression:         aws.String("attribute_exists(#exp) AND #exp <> :zero"),
+		ExpressionAttributeNames: map[string]string{"#exp": "expire_at"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":zero": &types.AttributeValueMemberN{Value: "0"},
+		},
+	})
+	var n int64
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return n, fmt.Errorf("failed to scan %s: %w", urlsTable, err)
+		}
+		for _, raw := range out.Items {
+			var item urlItem
+			if err := attributevalue.UnmarshalMap(raw, &item); err != nil {
+				return n, fmt.Errorf("failed to unmarshal item: %w", err)
+			}
+			created, err := time.Parse(time.RFC3339, item.CreatedAt)
+			if err != nil || created.Unix() != item.ExpireAt {
+				continue
+			}
+			_, err = c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+				TableName:                aws.String(urlsTable),
+				Key:                      shortIDKey(item.ShortID),
+				UpdateExpression:         aws.String("REMOVE #exp"),
+				ConditionExpression:      aws.String("#exp = :exp"),
+				ExpressionAttributeNames: map[string]string{"#exp": "expire_at"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":exp": &types.AttributeValueMemberN{Value: strconv.FormatInt(item.ExpireAt, 10)},
+				},
+			})
+			if isConditionFailed(err) {
+				continue // updated since the scan
+			}
+			if err != nil {
+				return n, fmt.Errorf("failed to clear expiry of %s: %w", item.ShortID, err)
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Update changes the destination and/or expiry of an existing item.
+func (c *DynamoClient) Update(ctx context.Context, shortID string, upd models.URLUpdate) error {
+	var sets, removes []string
+	attrs := map[string]types.AttributeValue{}
+	exprNames := map[string]string{}
+
+	if upd.OriginalURL != nil {
+		sets = append(sets, "#url = :u")
+		exprNames["#url"] = "original_url"
+		attrs[":u"] = &types.AttributeValueMemberS{Value: *upd.OriginalURL}
+	}
+
+	if upd.ExpireAt != nil {
+		exprNames["#exp"] = "expire_at"
+		if *upd.ExpireAt == 0 {
+			// never expires: drop the TTL attribute altogether
+			removes = append(removes, "#exp")
+		} else {
+			sets = append(sets, "#exp = :e")
+			attrs[":e"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*upd.ExpireAt, 10)}
+		}
+	}
+
+	if upd.Flagged != nil {
+		sets = append(sets, "#flag = :f")
+		exprNames["#flag"] = "flagged"
+		attrs[":f"] = &types.AttributeValueMemberBOOL{Value: *upd.Flagged}
+	}
+
+	if upd.WorkspaceID != nil {
+		exprNames["#ws"] = "workspace_id"
+		if *upd.WorkspaceID == "" {
+			removes = append(removes, "#ws")
+		} else {
+			sets = append(sets, "#ws = :w")
+			attrs[":w"] = &types.AttributeValueMemberS{Value: *upd.WorkspaceID}
+		}
+	}
+
+	if len(exprNames) == 0 {
+		return nil
+	}
+
+	var expr string
+	if len(sets) > 0 {
+		expr = "SET " + strings.Join(sets, ", ")
+	}
+	if len(removes) > 0 {
+		expr += " REMOVE " + strings.Join(removes, ", ")
+	}
+	if len(attrs) == 0 {
+		attrs = nil
+	}
+
+	_, err := c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(urlsTable),
+		Key:                       shortIDKey(shortID),
+		UpdateExpression:          aws.String(strings.TrimSpace(expr)),
+		ConditionExpression:       aws.String("attribute_exists(short_id)"),
+		ExpressionAttributeNames:  exprNames,
+		ExpressionAttributeValues: attrs,
+	})
+	if isConditionFailed(err) {
+		return repository.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an item from the Urls table.
+func (c *DynamoClient) Delete(ctx context.Context, shortID string) error {
+	_, err := c.DB.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(urlsTable),
+		Key:                 shortIDKey(shortID),
+		ConditionExpression: aws.String("attribute_exists(short_id)"),
+	})
+	if isConditionFailed(err) {
+		return repository.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete item: %w", err)
+	}
+	return nil
+}
+
+// List scans the Urls table one page at a time, skipping expired items. The
+// cursor is the short_id of the last item DynamoDB evaluated, so a page may
+// hold fewer than limit URLs even when more remain.
+func (c *DynamoClient) List(ctx context.Context, limit int32, cursor string) ([]*models.URL, string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(urlsTable),
+		FilterExpression:          aws.String("attribute_not_exists(expire_at) OR expire_at = :zero OR expire_at > :now"),
+		ExpressionAttributeValues: expiryValues(time.Now()),
+	}
+	if limit > 0 {
+		input.Limit = aws.Int32(limit)
+	}
+	if cursor != "" {
+		input.ExclusiveStartKey = shortIDKey(cursor)
+	}
+
+	out, err := c.DB.Scan(ctx, input)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to scan table: %w", err)
 	}
-	return data.OriginalUrl, nil
+
+	var items []urlItem
+	if err := attributevalue.UnmarshalListOfMaps(out.Items, &items); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal results: %w", err)
+	}
+
+	urls := make([]*models.URL, 0, len(items))
+	for _, item := range items {
+		urls = append(urls, item.toModel())
+	}
+
+	var next string
+	if val, ok := out.LastEvaluatedKey["short_id"].(*types.AttributeValueMemberS); ok {
+		next = val.Value
+	}
+	return urls, next, nil
+}
+
+// ListByOwner queries the owner index one page at a time, skipping expired
+// items. Like List, the cursor is the short_id of the last item evaluated.
+func (c *DynamoClient) ListByOwner(ctx context.Context, ownerID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return c.queryIndex(ctx, ownerIndex, "owner_id", ownerID, limit, cursor)
+}
+
+// ListByWorkspace queries the workspace index the same way.
+func (c *DynamoClient) ListByWorkspace(ctx context.Context, workspaceID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return c.queryIndex(ctx, workspaceIndex, "workspace_id", workspaceID, limit, cursor)
 }
 
-// IncrementClick increments the click counter for a short URL in the Urls table.
+// queryIndex pages through the Urls index whose partition key attr equals
+// value.
+func (c *DynamoClient) queryIndex(ctx context.Context, index, attr, value string, limit int32, cursor string) ([]*models.URL, string, error) {
+	values := expiryValues(time.Now())
+	values[":v"] = &types.AttributeValueMemberS{Value: value}
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(urlsTable),
+		IndexName:                 aws.String(index),
+		KeyConditionExpression:    aws.String("#k = :v"),
+		FilterExpression:          aws.String("attribute_not_exists(expire_at) OR expire_at = :zero OR expire_at > :now"),
+		ExpressionAttributeNames:  map[string]string{"#k": attr},
+		ExpressionAttributeValues: values,
+	}
+	if limit > 0 {
+		input.Limit = aws.Int32(limit)
+	}
+	if cursor != "" {
+		input.ExclusiveStartKey = shortIDKey(cursor)
+		input.ExclusiveStartKey[attr] = values[":v"]
+	}
+
+	out, err := c.DB.Query(ctx, input)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query %s: %w", index, err)
+	}
+
+	var items []urlItem
+	if err := attributevalue.UnmarshalListOfMaps(out.Items, &items); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal results: %w", err)
+	}
+
+	urls := make([]*models.URL, 0, len(items))
+	for _, item := range items {
+		urls = append(urls, item.toModel())
+	}
+
+	var next string
+	if val, ok := out.LastEvaluatedKey["short_id"].(*types.AttributeValueMemberS); ok {
+		next = val.Value
+	}
+	return urls, next, nil
+}
+
+// CountByOwner counts the live items of the owner index, following every
+// page of the query.
+func (c *DynamoClient) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	values := expiryValues(time.Now())
+	values[":v"] = &types.AttributeValueMemberS{Value: ownerID}
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(urlsTable),
+		IndexName:                 aws.String(ownerIndex),
+		KeyConditionExpression:    aws.String("owner_id = :v"),
+		FilterExpression:          aws.String("attribute_not_exists(expire_at) OR expire_at = :zero OR expire_at > :now"),
+		ExpressionAttributeValues: values,
+		Select:                    types.SelectCount,
+	}
+
+	var n int64
+	paginator := dynamodb.NewQueryPaginator(c.DB, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count %s: %w", ownerIndex, err)
+		}
+		n += int64(out.Count)
+	}
+	return n, nil
+}
+
+// IncrementClicks increments the click counter for a short URL in the Urls table.
 // This is safe to call when a redirect occurs.
-func (c *DynamoClient) IncrementClick(ctx context.Context, shortKey string) error {
+func (c *DynamoClient) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	values := expiryValues(time.Now())
+	values[":incr"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(n, 10)}
+
+	out, err := c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(urlsTable),
+		Key:       shortIDKey(shortID),
+		// Use if_not_exists to initialize clicks to 0 if the attribute is missing
+		UpdateExpression:                    aws.String("SET clicks = if_not_exists(clicks, :zero) + :incr"),
+		ConditionExpression:                 aws.String("attribute_exists(short_id) AND (attribute_not_exists(expire_at) OR expire_at = :zero OR expire_at > :now)"),
+		ExpressionAttributeValues:           values,
+		ReturnValues:                        types.ReturnValueUpdatedNew,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	})
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		// the old item is only returned when it exists but has expired
+		if ccf.Item != nil {
+			return 0, repository.ErrExpired
+		}
+		return 0, repository.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment clicks: %w", err)
+	}
+
+	var data struct {
+		Clicks int64 `dynamodbav:"clicks"`
+	}
+	if err := attributevalue.UnmarshalMap(out.Attributes, &data); err != nil {
+		return 0, fmt.Errorf("failed to parse updated data: %w", err)
+	}
+	return data.Clicks, nil
+}
+
+// Ping verifies the DynamoDB connection.
+func (c *DynamoClient) Ping(ctx context.Context) error {
+	_, err := c.DB.ListTables(ctx, &dynamodb.ListTablesInput{Limit: aws.Int32(1)})
+	return err
+}
+
+// NextSequence atomically increments the short_id item of the Counters table
+// and returns the new value.
+func (c *DynamoClient) NextSequence(ctx context.Context) (uint64, error) {
+	out, err := c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(countersTable),
+		Key: map[string]types.AttributeValue{
+			"name": &types.AttributeValueMemberS{Value: shortIDCounter},
+		},
+		UpdateExpression: aws.String("ADD #v :one"),
+		ExpressionAttributeNames: map[string]string{
+			"#v": "value",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":one": &types.AttributeValueMemberN{Value: "1"},
+		},
+		ReturnValues: types.ReturnValueUpdatedNew,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter: %w", err)
+	}
+
+	var data struct {
+		Value uint64 `dynamodbav:"value"`
+	}
+	if err := attributevalue.UnmarshalMap(out.Attributes, &data); err != nil {
+		return 0, fmt.Errorf("failed to parse counter: %w", err)
+	}
+	return data.Value, nil
+}
+
+// apiKeyItem mirrors the layout of an item in the ApiKeys table.
+type apiKeyItem struct {
+	KeyID     string   `dynamodbav:"key_id"`
+	Name      string   `dynamodbav:"name,omitempty"`
+	KeyHash   string   `dynamodbav:"key_hash"`
+	Scopes    []string `dynamodbav:"scopes"`
+	CreatedAt string   `dynamodbav:"created_at"`
+	RevokedAt int64    `dynamodbav:"revoked_at,omitempty"`
+}
+
+func keyIDKey(id string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key_id": &types.AttributeValueMemberS{Value: id},
+	}
+}
+
+// CreateAPIKey inserts a new item into the ApiKeys table.
+func (c *DynamoClient) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	item, err := attributevalue.MarshalMap(apiKeyItem{
+		KeyID:     key.ID,
+		Name:      key.Name,
+		KeyHash:   key.Hash,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+		RevokedAt: key.RevokedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal api key: %w", err)
+	}
+
+	_, err = c.DB.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(apiKeysTable),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(key_id)"),
+	})
+	if isConditionFailed(err) {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return nil
+}
+
+// GetAPIKey looks up a key id in the ApiKeys table.
+func (c *DynamoClient) GetAPIKey(ctx context.Context, id string) (*models.APIKey, error) {
+	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(apiKeysTable),
+		Key:       keyIDKey(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	if out.Item == nil {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+
+	var item apiKeyItem
+	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal api key: %w", err)
+	}
+	createdAt, _ := time.Parse(time.RFC3339, item.CreatedAt)
+	return &models.APIKey{
+		ID:        item.KeyID,
+		Name:      item.Name,
+		Hash:      item.KeyHash,
+		Scopes:    item.Scopes,
+		CreatedAt: createdAt,
+		RevokedAt: item.RevokedAt,
+	}, nil
+}
+
+// RevokeAPIKey sets revoked_at on an existing key that is not yet revoked.
+func (c *DynamoClient) RevokeAPIKey(ctx context.Context, id string, at int64) error {
 	_, err := c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: aws.String("Urls"),
+		TableName:           aws.String(apiKeysTable),
+		Key:                 keyIDKey(id),
+		UpdateExpression:    aws.String("SET revoked_at = if_not_exists(revoked_at, :at)"),
+		ConditionExpression: aws.String("attribute_exists(key_id)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":at": &types.AttributeValueMemberN{Value: strconv.FormatInt(at, 10)},
+		},
+	})
+	if isConditionFailed(err) {
+		return repository.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+// workspaceItem mirrors the layout of an item in the Workspaces table.
+type workspaceItem struct {
+	WorkspaceID string `dynamodbav:"workspace_id"`
+	Name        string `dynamodbav:"name"`
+	CreatedAt   string `dynamodbav:"created_at"`
+	CreatedBy   string `dynamodbav:"created_by,omitempty"`
+}
+
+// memberItem mirrors the layout of an item in the WorkspaceMembers table.
+type memberItem struct {
+	WorkspaceID string `dynamodbav:"workspace_id"`
+	UserID      string `dynamodbav:"user_id"`
+	Role        string `dynamodbav:"role"`
+	AddedAt     string `dynamodbav:"added_at"`
+}
+
+func memberKey(workspaceID, userID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"workspace_id": &types.AttributeValueMemberS{Value: workspaceID},
+		"user_id":      &types.AttributeValueMemberS{Value: userID},
+	}
+}
+
+func marshalMember(m *models.Member) (map[string]types.AttributeValue, error) {
+	item, err := attributevalue.MarshalMap(memberItem{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        string(m.Role),
+		AddedAt:     m.AddedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal member: %w", err)
+	}
+	return item, nil
+}
+
+// firstCheckFailed reports whether a transaction was cancelled because the
+// condition on its first item failed.
+func firstCheckFailed(err error) bool {
+	var tce *types.TransactionCanceledException
+	return errors.As(err, &tce) && len(tce.CancellationReasons) > 0 &&
+		aws.ToString(tce.CancellationReasons[0].Code) == "ConditionalCheckFailed"
+}
+
+// CreateWorkspace writes the workspace and its first member in one
+// transaction.
+func (c *DynamoClient) CreateWorkspace(ctx context.Context, ws *models.Workspace, admin *models.Member) error {
+	wsItem, err := attributevalue.MarshalMap(workspaceItem{
+		WorkspaceID: ws.ID,
+		Name:        ws.Name,
+		CreatedAt:   ws.CreatedAt.Format(time.RFC3339),
+		CreatedBy:   ws.CreatedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal workspace: %w", err)
+	}
+	adminItem, err := marshalMember(admin)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DB.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{Put: &types.Put{
+				TableName:           aws.String(workspacesTable),
+				Item:                wsItem,
+				ConditionExpression: aws.String("attribute_not_exists(workspace_id)"),
+			}},
+			{Put: &types.Put{
+				TableName: aws.String(membersTable),
+				Item:      adminItem,
+			}},
+		},
+	})
+	if firstCheckFailed(err) {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create workspace: %w", err)
+	}
+	return nil
+}
+
+// GetWorkspace looks up a workspace in the Workspaces table.
+func (c *DynamoClient) GetWorkspace(ctx context.Context, id string) (*models.Workspace, error) {
+	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(workspacesTable),
 		Key: map[string]types.AttributeValue{
-			"short_id": &types.AttributeValueMemberS{Value: shortKey},
+			"workspace_id": &types.AttributeValueMemberS{Value: id},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	if out.Item == nil {
+		return nil, repository.ErrWorkspaceNotFound
+	}
+
+	var item workspaceItem
+	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workspace: %w", err)
+	}
+	createdAt, _ := time.Parse(time.RFC3339, item.CreatedAt)
+	return &models.Workspace{
+		ID:        item.WorkspaceID,
+		Name:      item.Name,
+		CreatedAt: createdAt,
+		CreatedBy: item.CreatedBy,
+	}, nil
+}
+
+// PutMember writes a membership, checking in the same transaction that the
+// workspace exists.
+func (c *DynamoClient) PutMember(ctx context.Context, m *models.Member) error {
+	item, err := marshalMember(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DB.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{ConditionCheck: &types.ConditionCheck{
+				TableName: aws.String(workspacesTable),
+				Key: map[string]types.AttributeValue{
+					"workspace_id": &types.AttributeValueMemberS{Value: m.WorkspaceID},
+				},
+				ConditionExpression: aws.String("attribute_exists(workspace_id)"),
+			}},
+			{Put: &types.Put{
+				TableName: aws.String(membersTable),
+				Item:      item,
+			}},
+		},
+	})
+	if firstCheckFailed(err) {
+		return repository.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to put member: %w", err)
+	}
+	return nil
+}
+
+// GetMember looks up a membership in the WorkspaceMembers table.
+func (c *DynamoClient) GetMember(ctx context.Context, workspaceID, userID string) (*models.Member, error) {
+	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(membersTable),
+		Key:       memberKey(workspaceID, userID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if out.Item == nil {
+		return nil, repository.ErrMemberNotFound
+	}
+
+	var item memberItem
+	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal member: %w", err)
+	}
+	addedAt, _ := time.Parse(time.RFC3339, item.AddedAt)
+	return &models.Member{
+		WorkspaceID: item.WorkspaceID,
+		UserID:      item.UserID,
+		Role:        models.Role(item.Role),
+		AddedAt:     addedAt,
+	}, nil
+}
+
+// DeleteMember removes a membership from the WorkspaceMembers table.
+func (c *DynamoClient) DeleteMember(ctx context.Context, workspaceID, userID string) error {
+	_, err := c.DB.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(membersTable),
+		Key:                 memberKey(workspaceID, userID),
+		ConditionExpression: aws.String("attribute_exists(user_id)"),
+	})
+	if isConditionFailed(err) {
+		return repository.ErrMemberNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete member: %w", err)
+	}
+	return nil
+}
+
+// domainItem mirrors the layout of an item in the Domains table.
+type domainItem struct {
+	Domain            string `dynamodbav:"domain"`
+	VerificationToken string `dynamodbav:"verification_token"`
+	CreatedAt         string `dynamodbav:"created_at"`
+	CreatedBy         string `dynamodbav:"created_by,omitempty"`
+	WorkspaceID       string `dynamodbav:"workspace_id,omitempty"`
+	VerifiedAt        int64  `dynamodbav:"verified_at,omitempty"`
+}
+
+func domainKey(name string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"domain": &types.AttributeValueMemberS{Value: name},
+	}
+}
+
+// CreateDomain inserts a new item into the Domains table.
+func (c *DynamoClient) CreateDomain(ctx context.Context, d *models.Domain) error {
+	item, err := attributevalue.MarshalMap(domainItem{
+		Domain:            d.Name,
+		VerificationToken: d.VerificationToken,
+		CreatedAt:         d.CreatedAt.Format(time.RFC3339),
+		CreatedBy:         d.CreatedBy,
+		WorkspaceID:       d.WorkspaceID,
+		VerifiedAt:        d.VerifiedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal domain: %w", err)
+	}
+
+	_, err = c.DB.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(domainsTable),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(#d)"),
+		ExpressionAttributeNames: map[string]string{
+			"#d": "domain",
+		},
+	})
+	if isConditionFailed(err) {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert domain: %w", err)
+	}
+	return nil
+}
+
+// GetDomain looks up a name in the Domains table.
+func (c *DynamoClient) GetDomain(ctx context.Context, name string) (*models.Domain, error) {
+	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(domainsTable),
+		Key:       domainKey(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain: %w", err)
+	}
+	if out.Item == nil {
+		return nil, repository.ErrDomainNotFound
+	}
+
+	var item domainItem
+	if err := attributevalue.UnmarshalMap(out.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal domain: %w", err)
+	}
+	createdAt, _ := time.Parse(time.RFC3339, item.CreatedAt)
+	return &models.Domain{
+		Name:              item.Domain,
+		VerificationToken: item.VerificationToken,
+		CreatedAt:         createdAt,
+		CreatedBy:         item.CreatedBy,
+		WorkspaceID:       item.WorkspaceID,
+		VerifiedAt:        item.VerifiedAt,
+	}, nil
+}
+
+// VerifyDomain sets verified_at on an existing domain that is not yet
+// verified.
+func (c *DynamoClient) VerifyDomain(ctx context.Context, name string, at int64) error {
+	_, err := c.DB.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(domainsTable),
+		Key:                 domainKey(name),
+		UpdateExpression:    aws.String("SET verified_at = if_not_exists(verified_at, :at)"),
+		ConditionExpression: aws.String("attribute_exists(#d)"),
+		ExpressionAttributeNames: map[string]string{
+			"#d": "domain",
 		},
-		// Use if_not_exists to initialize clicks to 0 if the attribute is missing
-		UpdateExpression: aws.String("SET clicks = if_not_exists(clicks, :zero) + :incr"),
 		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":zero": &types.AttributeValueMemberN{Value: "0"},
-			":incr": &types.AttributeValueMemberN{Value: "1"},
+			":at": &types.AttributeValueMemberN{Value: strconv.FormatInt(at, 10)},
 		},
 	})
+	if isConditionFailed(err) {
+		return repository.ErrDomainNotFound
+	}
 	if err != nil {
-		return fmt.Errorf("failed to increment clicks: %w", err)
+		return fmt.Errorf("failed to verify domain: %w", err)
 	}
 	return nil
 }
+
+func creationsCounterKey(ownerID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"name": &types.AttributeValueMemberS{Value: creationsCounterPrefix + ownerID},
+	}
+}
+
+// DailyCreations reads the owner's item of the Counters table.
+func (c *DynamoClient) DailyCreations(ctx context.Context, ownerID, day string) (int64, error) {
+	out, err := c.DB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(countersTable),
+		Key:            creationsCounterKey(ownerID),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get daily creations: %w", err)
+	}
+
+	var data struct {
+		Day   string `dynamodbav:"day"`
+		Value int64  `dynamodbav:"value"`
+	}
+	if err := attributevalue.UnmarshalMap(out.Item, &data); err != nil {
+		return 0, fmt.Errorf("failed to parse daily creations: %w", err)
+	}
+	if data.Day != day {
+		return 0, nil
+	}
+	return data.Value, nil
+}
+
+// AddDailyCreation updates the owner's item of the Counters table. The
+// count is bumped if the item is for day and stays within limit, or
+// otherwise restarted if it is for an earlier day; the two conditional
+// writes are retried once in case another request restarted the day in
+// between.
+func (c *DynamoClient) AddDailyCreation(ctx context.Context, ownerID, day string, delta, limit int64) (int64, error) {
+	if delta <= 0 {
+		return c.releaseDailyCreation(ctx, ownerID, day, -delta)
+	}
+	if limit > 0 && delta > limit {
+		return c.dailyCreationsExceeded(ctx, ownerID, day)
+	}
+
+	names := map[string]string{"#d": "day", "#v": "value"}
+	sameDay, sameDayValues := "#d = :day", map[string]int64{":delta": delta}
+	if limit > 0 {
+		sameDay += " AND #v <= :cap"
+		sameDayValues[":cap"] = limit - delta
+	}
+	for range 2 {
+		n, err := c.updateDailyCreations(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(countersTable),
+			Key:                       creationsCounterKey(ownerID),
+			UpdateExpression:          aws.String("ADD #v :delta"),
+			ConditionExpression:       aws.String(sameDay),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: dailyCreationValues(day, sameDayValues),
+			ReturnValues:              types.ReturnValueUpdatedNew,
+		})
+		if !isConditionFailed(err) {
+			return n, err
+		}
+
+		n, err = c.updateDailyCreations(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(countersTable),
+			Key:                       creationsCounterKey(ownerID),
+			UpdateExpression:          aws.String("SET #d = :day, #v = :delta"),
+			ConditionExpression:       aws.String("attribute_not_exists(#d) OR #d <> :day"),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: dailyCreationValues(day, map[string]int64{":delta": delta}),
+			ReturnValues:              types.ReturnValueUpdatedNew,
+		})
+		if !isConditionFailed(err) {
+			return n, err
+		}
+	}
+	return c.dailyCreationsExceeded(ctx, ownerID, day)
+}
+
+// dailyCreationsExceeded returns the owner's count for day with
+// ErrQuotaExceeded.
+func (c *DynamoClient) dailyCreationsExceeded(ctx context.Context, ownerID, day string) (int64, error) {
+	n, err := c.DailyCreations(ctx, ownerID, day)
+	if err != nil {
+		return 0, err
+	}
+	return n, repository.ErrQuotaExceeded
+}
+
+// releaseDailyCreation takes n off the owner's count for day, if that is
+// the day the item holds and the count allows it.
+func (c *DynamoClient) releaseDailyCreation(ctx context.Context, ownerID, day string, n int64) (int64, error) {
+	count, err := c.updateDailyCreations(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(countersTable),
+		Key:                       creationsCounterKey(ownerID),
+		UpdateExpression:          aws.String("ADD #v :delta"),
+		ConditionExpression:       aws.String("#d = :day AND #v >= :n"),
+		ExpressionAttributeNames:  map[string]string{"#d": "day", "#v": "value"},
+		ExpressionAttributeValues: dailyCreationValues(day, map[string]int64{":delta": -n, ":n": n}),
+		ReturnValues:              types.ReturnValueUpdatedNew,
+	})
+	if isConditionFailed(err) {
+		return c.DailyCreations(ctx, ownerID, day)
+	}
+	return count, err
+}
+
+// dailyCreationValues binds :day and the given numeric placeholders.
+func dailyCreationValues(day string, numbers map[string]int64) map[string]types.AttributeValue {
+	values := map[string]types.AttributeValue{
+		":day": &types.AttributeValueMemberS{Value: day},
+	}
+	for name, v := range numbers {
+		values[name] = &types.AttributeValueMemberN{Value: strconv.FormatInt(v, 10)}
+	}
+	return values
+}
+
+func (c *DynamoClient) updateDailyCreations(ctx context.Context, input *dynamodb.UpdateItemInput) (int64, error) {
+	out, err := c.DB.UpdateItem(ctx, input)
+	if isConditionFailed(err) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to update daily creations: %w", err)
+	}
+
+	var data struct {
+		Value int64 `dynamodbav:"value"`
+	}
+	if err := attributevalue.UnmarshalMap(out.Attributes, &data); err != nil {
+		return 0, fmt.Errorf("failed to parse daily creations: %w", err)
+	}
+	return data.Value, nil
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// TestExpiredLinksCanBeRevived checks that every local backend applies the
+// same rule to expired links: reads report ErrExpired along with the link,
+// and updates still apply.
+func TestExpiredLinksCanBeRevived(t *testing.T) {
+	stores := map[string]repository.URLStore{
+		"memory": NewMemoryStore(),
+		"bolt":   newTestBoltStore(t),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			err := store.Create(ctx, &models.URL{
+				ShortID:     "old",
+				OriginalURL: "https://example.com",
+				OwnerID:     "key:OWNER",
+				ExpireAt:    time.Now().Add(-time.Minute).Unix(),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := store.Get(ctx, "old")
+			if !errors.Is(err, repository.ErrExpired) {
+				t.Fatalf("Get = %v, want ErrExpired", err)
+			}
+			if got == nil || got.OwnerID != "key:OWNER" {
+				t.Fatalf("Get returned %+v, want the expired link", got)
+			}
+			if _, err := store.IncrementClicks(ctx, "old", 1); !errors.Is(err, repository.ErrExpired) {
+				t.Errorf("IncrementClicks = %v, want ErrExpired", err)
+			}
+
+			never := int64(0)
+			if err := store.Update(ctx, "old", models.URLUpdate{ExpireAt: &never}); err != nil {
+				t.Fatalf("Update = %v", err)
+			}
+			if _, err := store.Get(ctx, "old"); err != nil {
+				t.Errorf("Get after clearing the expiry = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// MemoryStore is an in-process repository.URLStore for local development
+// and tests. Nothing is persisted across restarts.
+//
+// Expired URLs behave like items awaiting DynamoDB TTL deletion: Get and
+// IncrementClicks report repository.ErrExpired, List skips them, Update and
+// Delete still apply, and Create may reuse their short id.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	urls map[string]*models.URL
+	keys map[string]*models.APIKey
+	// workspaces and members are keyed by workspace id; members maps user
+	// id to membership within each workspace.
+	workspaces map[string]*models.Workspace
+	members    map[string]map[string]*models.Member
+	domains    map[string]*models.Domain
+	// creations holds each owner's latest daily creation count.
+	creations map[string]dailyCount
+	seq       atomic.Uint64
+}
+
+var (
+	_ repository.URLStore       = (*MemoryStore)(nil)
+	_ repository.APIKeyStore    = (*MemoryStore)(nil)
+	_ repository.WorkspaceStore = (*MemoryStore)(nil)
+	_ repository.DomainStore    = (*MemoryStore)(nil)
+	_ repository.QuotaStore     = (*MemoryStore)(nil)
+)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		urls: make(map[string]*models.URL),
+		keys: make(map[string]*models.APIKey),
+
+		workspaces: make(map[string]*models.Workspace),
+		members:    make(map[string]map[string]*models.Member),
+		domains:    make(map[string]*models.Domain),
+		creations:  make(map[string]dailyCount),
+	}
+}
+
+func (m *MemoryStore) Create(ctx context.Context, url *models.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.urls[url.ShortID]; ok && !existing.Expired(time.Now()) {
+		return repository.ErrAlreadyExists
+	}
+	stored := *url
+	m.urls[url.ShortID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, shortID string) (*models.URL, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	u, ok := m.urls[shortID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	out := *u
+	if u.Expired(time.Now()) {
+		return &out, repository.ErrExpired
+	}
+	return &out, nil
+}
+
+func (m *MemoryStore) Update(ctx context.Context, shortID string, upd models.URLUpdate) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.urls[shortID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	if upd.OriginalURL != nil {
+		u.OriginalURL = *upd.OriginalURL
+	}
+	if upd.ExpireAt != nil {
+		u.ExpireAt = *upd.ExpireAt
+	}
+	if upd.Flagged != nil {
+		u.Flagged = *upd.Flagged
+	}
+	if upd.WorkspaceID != nil {
+		u.WorkspaceID = *upd.WorkspaceID
+	}
+	return nil
+}
+
+func (m *MemoryStore) Delete(ctx context.Context, shortID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.urls[shortID]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(m.urls, shortID)
+	return nil
+}
+
+// List returns URLs ordered by short id. The cursor is the short id of the
+// last URL in the previous page, mirroring DynamoDB's LastEvaluatedKey.
+func (m *MemoryStore) List(ctx context.Context, limit int32, cursor string) ([]*models.URL, string, error) {
+	return m.list(limit, cursor, func(*models.URL) bool { return true })
+}
+
+// ListByOwner is List restricted to URLs owned by ownerID.
+func (m *MemoryStore) ListByOwner(ctx context.Context, ownerID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return m.list(limit, cursor, func(u *models.URL) bool { return u.OwnerID == ownerID })
+}
+
+// ListByWorkspace is List restricted to URLs in workspaceID.
+func (m *MemoryStore) ListByWorkspace(ctx context.Context, workspaceID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return m.list(limit, cursor, func(u *models.URL) bool { return u.WorkspaceID == workspaceID })
+}
+
+func (m *MemoryStore) list(limit int32, cursor string, match func(*models.URL) bool) ([]*models.URL, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now()
+	ids := make([]string, 0, len(m.urls))
+	for id, u := range m.urls {
+		if id > cursor && !u.Expired(now) && match(u) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var next string
+	if limit > 0 && len(ids) > int(limit) {
+		ids = ids[:limit]
+		next = ids[len(ids)-1]
+	}
+
+	urls := make([]*models.URL, 0, len(ids))
+	for _, id := range ids {
+		u := *m.urls[id]
+		urls = append(urls, &u)
+	}
+	return urls, next, nil
+}
+
+func (m *MemoryStore) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now()
+	var n int64
+	for _, u := range m.urls {
+		if u.OwnerID == ownerID && !u.Expired(now) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *MemoryStore) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.urls[shortID]
+	if !ok {
+		return 0, repository.ErrNotFound
+	}
+	if u.Expired(time.Now()) {
+		return 0, repository.ErrExpired
+	}
+	u.Clicks += n
+	return u.Clicks, nil
+}
+
+func (m *MemoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+// NextSequence returns the next value of a process-local counter.
+func (m *MemoryStore) NextSequence(ctx context.Context) (uint64, error) {
+	return m.seq.Add(1), nil
+}
+
+func (m *MemoryStore) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.keys[key.ID]; ok {
+		return repository.ErrAlreadyExists
+	}
+	stored := *key
+	stored.Scopes = slices.Clone(key.Scopes)
+	m.keys[key.ID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetAPIKey(ctx context.Context, id string) (*models.APIKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	k, ok := m.keys[id]
+	if !ok {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+	out := *k
+	out.Scopes = slices.Clone(k.Scopes)
+	return &out, nil
+}
+
+func (m *MemoryStore) RevokeAPIKey(ctx context.Context, id string, at int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k, ok := m.keys[id]
+	if !ok {
+		return repository.ErrAPIKeyNotFound
+	}
+	if k.RevokedAt == 0 {
+		k.RevokedAt = at
+	}
+	return nil
+}
+
+func (m *MemoryStore) CreateWorkspace(ctx context.Context, ws *models.Workspace, admin *models.Member) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.workspaces[ws.ID]; ok {
+		return repository.ErrAlreadyExists
+	}
+	storedWS, storedAdmin := *ws, *admin
+	m.workspaces[ws.ID] = &storedWS
+	m.members[ws.ID] = map[string]*models.Member{admin.UserID: &storedAdmin}
+	return nil
+}
+
+func (m *MemoryStore) GetWorkspace(ctx context.Context, id string) (*models.Workspace, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, repository.ErrWorkspaceNotFound
+	}
+	out := *ws
+	return &out, nil
+}
+
+func (m *MemoryStore) PutMember(ctx context.Context, member *models.Member) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	members, ok := m.members[member.WorkspaceID]
+	if !ok {
+		return repository.ErrWorkspaceNotFound
+	}
+	stored := *member
+	members[member.UserID] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetMember(ctx context.Context, workspaceID, userID string) (*models.Member, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	member, ok := m.members[workspaceID][userID]
+	if !ok {
+		return nil, repository.ErrMemberNotFound
+	}
+	out := *member
+	return &out, nil
+}
+
+func (m *MemoryStore) DeleteMember(ctx context.Context, workspaceID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.members[workspaceID][userID]; !ok {
+		return repository.ErrMemberNotFound
+	}
+	delete(m.members[workspaceID], userID)
+	return nil
+}
+
+func (m *MemoryStore) CreateDomain(ctx context.Context, d *models.Domain) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.domains[d.Name]; ok {
+		return repository.ErrAlreadyExists
+	}
+	stored := *d
+	m.domains[d.Name] = &stored
+	return nil
+}
+
+func (m *MemoryStore) GetDomain(ctx context.Context, name string) (*models.Domain, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	d, ok := m.domains[name]
+	if !ok {
+		return nil, repository.ErrDomainNotFound
+	}
+	out := *d
+	return &out, nil
+}
+
+func (m *MemoryStore) VerifyDomain(ctx context.Context, name string, at int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	d, ok := m.domains[name]
+	if !ok {
+		return repository.ErrDomainNotFound
+	}
+	if d.VerifiedAt == 0 {
+		d.VerifiedAt = at
+	}
+	return nil
+}
+
+func (m *MemoryStore) DailyCreations(ctx context.Context, ownerID, day string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.creations[ownerID].on(day), nil
+}
+
+func (m *MemoryStore) AddDailyCreation(ctx context.Context, ownerID, day string, delta, limit int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, err := m.creations[ownerID].add(day, delta, limit)
+	if err != nil {
+		return c.on(day), err
+	}
+	m.creations[ownerID] = c
+	return c.on(day), nil
+}
+
+// dailyCount is an owner's creation count for one day, as kept by the
+// memory and bolt stores.
+type dailyCount struct {
+	Day   string `json:"day"`
+	Count int64  `json:"count"`
+}
+
+// on returns the count for day, which is zero if c is for another day.
+func (c dailyCount) on(day string) int64 {
+	if c.Day != day {
+		return 0
+	}
+	return c.Count
+}
+
+// add implements repository.QuotaStore.AddDailyCreation on c, returning c
+// unchanged with the error. Releases for a day other than c's leave it
+// unchanged too.
+func (c dailyCount) add(day string, delta, limit int64) (dailyCount, error) {
+	if delta <= 0 && c.Day != day {
+		return c, nil
+	}
+	n := c.on(day) + delta
+	if delta > 0 && limit > 0 && n > limit {
+		return c, repository.ErrQuotaExceeded
+	}
+	return dailyCount{Day: day, Count: max(n, 0)}, nil
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+func TestMemoryStoreCRUD(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	url := &models.URL{ShortID: "abc", OriginalURL: "https://example.com", OwnerID: "key:OWNER"}
+	if err := store.Create(ctx, url); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Create(ctx, &models.URL{ShortID: "abc"}); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Create of a taken id = %v, want ErrAlreadyExists", err)
+	}
+
+	// The store keeps its own copies.
+	url.OriginalURL = "https://changed.example"
+	got, err := store.Get(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Clicks = 99
+	if again, _ := store.Get(ctx, "abc"); again.OriginalURL != "https://example.com" || again.Clicks != 0 {
+		t.Errorf("stored URL changed through a caller's copy: %+v", again)
+	}
+
+	dest := "https://example.org"
+	if err := store.Update(ctx, "abc", models.URLUpdate{OriginalURL: &dest}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := store.Get(ctx, "abc"); got.OriginalURL != dest || got.OwnerID != "key:OWNER" {
+		t.Errorf("Get after Update = %+v", got)
+	}
+	if err := store.Update(ctx, "nope", models.URLUpdate{OriginalURL: &dest}); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Update of an unknown id = %v, want ErrNotFound", err)
+	}
+
+	if err := store.Delete(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx, "abc"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Get after Delete = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "abc"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("second Delete = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStoreListsPageAndSkipExpired(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	expired := time.Now().Add(-time.Minute).Unix()
+	for _, u := range []*models.URL{
+		{ShortID: "a", OwnerID: "key:A"},
+		{ShortID: "b", OwnerID: "key:B", WorkspaceID: "TEAM"},
+		{ShortID: "c", OwnerID: "key:A", ExpireAt: expired},
+		{ShortID: "d", OwnerID: "key:A", WorkspaceID: "TEAM"},
+		{ShortID: "e", OwnerID: "key:B"},
+	} {
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var pages [][]string
+	cursor := ""
+	for {
+		urls, next, err := store.List(ctx, 2, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, u := range urls {
+			ids = append(ids, u.ShortID)
+		}
+		pages = append(pages, ids)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if len(pages) != 2 || !slices.Equal(pages[0], []string{"a", "b"}) || !slices.Equal(pages[1], []string{"d", "e"}) {
+		t.Errorf("List pages = %v, want [[a b] [d e]]", pages)
+	}
+
+	mine, _, _ := store.ListByOwner(ctx, "key:A", 0, "")
+	if ids := shortIDs(mine); !slices.Equal(ids, []string{"a", "d"}) {
+		t.Errorf("ListByOwner = %v, want [a d]", ids)
+	}
+	team, _, _ := store.ListByWorkspace(ctx, "TEAM", 0, "")
+	if ids := shortIDs(team); !slices.Equal(ids, []string{"b", "d"}) {
+		t.Errorf("ListByWorkspace = %v, want [b d]", ids)
+	}
+	if n, _ := store.CountByOwner(ctx, "key:A"); n != 2 {
+		t.Errorf("CountByOwner = %d, want 2 active links", n)
+	}
+
+	// Expired ids may be reused.
+	if err := store.Create(ctx, &models.URL{ShortID: "c", OwnerID: "key:B"}); err != nil {
+		t.Errorf("Create over an expired link = %v", err)
+	}
+}
+
+func TestMemoryStoreIncrementClicksConcurrently(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.Create(ctx, &models.URL{ShortID: "abc"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := store.IncrementClicks(ctx, "abc", 2); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, _ := store.Get(ctx, "abc"); got.Clicks != 100 {
+		t.Errorf("Clicks = %d, want 100", got.Clicks)
+	}
+	if _, err := store.IncrementClicks(ctx, "nope", 1); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("IncrementClicks of an unknown id = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStoreDailyCreations(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	for i := range 3 {
+		if _, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", 1, 3); err != nil {
+			t.Fatalf("creation %d = %v", i+1, err)
+		}
+	}
+	if n, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", 1, 3); !errors.Is(err, repository.ErrQuotaExceeded) || n != 3 {
+		t.Errorf("creation past the limit = %d, %v, want 3 and ErrQuotaExceeded", n, err)
+	}
+	if n, _ := store.AddDailyCreation(ctx, "key:A", "2026-01-01", -1, 3); n != 2 {
+		t.Errorf("count after a release = %d, want 2", n)
+	}
+	if n, _ := store.DailyCreations(ctx, "key:A", "2026-01-02"); n != 0 {
+		t.Errorf("count on the next day = %d, want 0", n)
+	}
+	if n, _ := store.AddDailyCreation(ctx, "key:A", "2026-01-02", -5, 3); n != 0 {
+		t.Errorf("count after releasing more than was added = %d, want 0", n)
+	}
+}
+
+func shortIDs(urls []*models.URL) []string {
+	ids := make([]string, len(urls))
+	for i, u := range urls {
+		ids[i] = u.ShortID
+	}
+	return ids
+}
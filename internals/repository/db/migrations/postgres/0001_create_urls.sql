@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS urls (
+    short_id     TEXT PRIMARY KEY,
+    original_url TEXT NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expire_at    BIGINT NOT NULL DEFAULT 0,
+    clicks       BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1 @@
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS flagged BOOLEAN NOT NULL DEFAULT FALSE;
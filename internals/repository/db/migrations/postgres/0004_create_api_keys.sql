@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id     TEXT PRIMARY KEY,
+    name       TEXT NOT NULL DEFAULT '',
+    key_hash   TEXT NOT NULL,
+    scopes     TEXT NOT NULL, -- space separated
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at BIGINT NOT NULL DEFAULT 0
+);
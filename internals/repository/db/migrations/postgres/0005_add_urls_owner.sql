@@ -0,0 +1,2 @@
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS owner_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS urls_owner_id_idx ON urls (owner_id, short_id);
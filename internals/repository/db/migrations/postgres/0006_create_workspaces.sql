@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS workspaces (
+    workspace_id TEXT PRIMARY KEY,
+    name         TEXT NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by   TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id TEXT NOT NULL REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    user_id      TEXT NOT NULL,
+    role         TEXT NOT NULL,
+    added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS workspace_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS urls_workspace_id_idx ON urls (workspace_id, short_id);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS daily_creations (
+    owner_id TEXT PRIMARY KEY,
+    day      DATE NOT NULL,
+    count    BIGINT NOT NULL DEFAULT 0
+);
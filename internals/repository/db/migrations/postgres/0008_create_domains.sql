@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS domains (
+    name               TEXT PRIMARY KEY,
+    verification_token TEXT NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by         TEXT NOT NULL DEFAULT '',
+    workspace_id       TEXT NOT NULL DEFAULT '',
+    verified_at        BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,605 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// postgresMigrations holds the versioned schema files. Each file is named
+// NNNN_description.sql and is applied once, in version order.
+//
+//go:embed migrations/postgres/*.sql
+var postgresMigrations embed.FS
+
+// postgresMigrationLock is the advisory lock key held while migrating so
+// that replicas starting together do not race.
+const postgresMigrationLock = 7_236_451
+
+// PostgresStore is a repository.URLStore backed by a PostgreSQL urls table.
+// Expiry is handled the same way as MemoryStore.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+var (
+	_ repository.URLStore       = (*PostgresStore)(nil)
+	_ repository.APIKeyStore    = (*PostgresStore)(nil)
+	_ repository.WorkspaceStore = (*PostgresStore)(nil)
+	_ repository.DomainStore    = (*PostgresStore)(nil)
+	_ repository.QuotaStore     = (*PostgresStore)(nil)
+)
+
+// NewPostgresStore connects to dsn and applies any pending migrations.
+func NewPostgresStore(ctx context.Context, dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	if err := migratePostgres(ctx, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate postgres: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+type postgresMigration struct {
+	version int
+	name    string
+}
+
+func loadPostgresMigrations() ([]postgresMigration, error) {
+	names, err := fs.Glob(postgresMigrations, "migrations/postgres/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]postgresMigration, 0, len(names))
+	for _, name := range names {
+		base := name[strings.LastIndex(name, "/")+1:]
+		prefix, _, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s: missing version prefix", base)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version: %w", base, err)
+		}
+		migrations = append(migrations, postgresMigration{version: version, name: name})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+func migratePostgres(ctx context.Context, db *sql.DB) error {
+	migrations, err := loadPostgresMigrations()
+	if err != nil {
+		return err
+	}
+
+	// Advisory locks belong to a session, so pin a single connection.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", postgresMigrationLock); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", postgresMigrationLock)
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return err
+	}
+
+	var current int
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		body, err := postgresMigrations.ReadFile(m.name)
+		if err != nil {
+			return err
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, string(body)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", m.version, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", m.version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", m.version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration %d: %w", m.version, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying connection pool.
+func (p *PostgresStore) Close() error {
+	return p.db.Close()
+}
+
+const postgresColumns = "short_id, original_url, created_at, expire_at, clicks, flagged, owner_id, workspace_id"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPostgresURL(row rowScanner) (*models.URL, error) {
+	var u models.URL
+	if err := row.Scan(&u.ShortID, &u.OriginalURL, &u.CreatedAt, &u.ExpireAt, &u.Clicks, &u.Flagged, &u.OwnerID, &u.WorkspaceID); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// Create inserts a new row. A row whose expiry has passed may be replaced,
+// any other conflict is reported as repository.ErrAlreadyExists.
+func (p *PostgresStore) Create(ctx context.Context, url *models.URL) error {
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO urls (`+postgresColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (short_id) DO UPDATE
+		SET original_url = EXCLUDED.original_url,
+		    created_at = EXCLUDED.created_at,
+		    expire_at = EXCLUDED.expire_at,
+		    clicks = EXCLUDED.clicks,
+		    flagged = EXCLUDED.flagged,
+		    owner_id = EXCLUDED.owner_id,
+		    workspace_id = EXCLUDED.workspace_id
+		WHERE urls.expire_at <> 0 AND urls.expire_at <= $9`,
+		url.ShortID, url.OriginalURL, url.CreatedAt, url.ExpireAt, url.Clicks, url.Flagged, url.OwnerID, url.WorkspaceID, time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf("failed to insert url: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (p *PostgresStore) Get(ctx context.Context, shortID string) (*models.URL, error) {
+	u, err := scanPostgresURL(p.db.QueryRowContext(ctx,
+		"SELECT "+postgresColumns+" FROM urls WHERE short_id = $1", shortID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get url: %w", err)
+	}
+	if u.Expired(time.Now()) {
+		return u, repository.ErrExpired
+	}
+	return u, nil
+}
+
+func (p *PostgresStore) Update(ctx context.Context, shortID string, upd models.URLUpdate) error {
+	var (
+		sets []string
+		args = []any{shortID}
+	)
+	if upd.OriginalURL != nil {
+		args = append(args, *upd.OriginalURL)
+		sets = append(sets, fmt.Sprintf("original_url = $%d", len(args)))
+	}
+	if upd.ExpireAt != nil {
+		args = append(args, *upd.ExpireAt)
+		sets = append(sets, fmt.Sprintf("expire_at = $%d", len(args)))
+	}
+	if upd.Flagged != nil {
+		args = append(args, *upd.Flagged)
+		sets = append(sets, fmt.Sprintf("flagged = $%d", len(args)))
+	}
+	if upd.WorkspaceID != nil {
+		args = append(args, *upd.WorkspaceID)
+		sets = append(sets, fmt.Sprintf("workspace_id = $%d", len(args)))
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	res, err := p.db.ExecContext(ctx,
+		"UPDATE urls SET "+strings.Join(sets, ", ")+" WHERE short_id = $1", args...)
+	if err != nil {
+		return fmt.Errorf("failed to update url: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresStore) Delete(ctx context.Context, shortID string) error {
+	res, err := p.db.ExecContext(ctx, "DELETE FROM urls WHERE short_id = $1", shortID)
+	if err != nil {
+		return fmt.Errorf("failed to delete url: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// List pages through live URLs ordered by short_id. The cursor is the last
+// short_id of the previous page, so each page is a keyset range scan on the
+// primary key rather than an OFFSET.
+func (p *PostgresStore) List(ctx context.Context, limit int32, cursor string) ([]*models.URL, string, error) {
+	return p.list(ctx, limit, cursor, "")
+}
+
+// ListByOwner is List restricted to one owner, served by the
+// (owner_id, short_id) index.
+func (p *PostgresStore) ListByOwner(ctx context.Context, ownerID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return p.list(ctx, limit, cursor, "owner_id = $3 AND", ownerID)
+}
+
+// ListByWorkspace is List restricted to one workspace, served by the
+// (workspace_id, short_id) index.
+func (p *PostgresStore) ListByWorkspace(ctx context.Context, workspaceID string, limit int32, cursor string) ([]*models.URL, string, error) {
+	return p.list(ctx, limit, cursor, "workspace_id = $3 AND", workspaceID)
+}
+
+// list runs the keyset query behind List and ListByOwner. filter is an
+// extra condition whose placeholders start at $3 and bind filterArgs.
+func (p *PostgresStore) list(ctx context.Context, limit int32, cursor, filter string, filterArgs ...any) ([]*models.URL, string, error) {
+	query := "SELECT " + postgresColumns + ` FROM urls
+		WHERE ` + filter + ` short_id > $1 AND (expire_at = 0 OR expire_at > $2)
+		ORDER BY short_id`
+	args := append([]any{cursor, time.Now().Unix()}, filterArgs...)
+	if limit > 0 {
+		// fetch one extra row to learn whether another page exists
+		args = append(args, limit+1)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list urls: %w", err)
+	}
+	defer rows.Close()
+
+	var urls []*models.URL
+	for rows.Next() {
+		u, err := scanPostgresURL(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan url: %w", err)
+		}
+		urls = append(urls, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("failed to list urls: %w", err)
+	}
+
+	var next string
+	if limit > 0 && len(urls) > int(limit) {
+		urls = urls[:limit]
+		next = urls[len(urls)-1].ShortID
+	}
+	return urls, next, nil
+}
+
+// CountByOwner counts live URLs using the (owner_id, short_id) index.
+func (p *PostgresStore) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	var n int64
+	err := p.db.QueryRowContext(ctx, `
+		SELECT count(*) FROM urls
+		WHERE owner_id = $1 AND (expire_at = 0 OR expire_at > $2)`,
+		ownerID, time.Now().Unix()).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count urls: %w", err)
+	}
+	return n, nil
+}
+
+// IncrementClicks bumps the counter in a single atomic UPDATE.
+func (p *PostgresStore) IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error) {
+	var clicks int64
+	err := p.db.QueryRowContext(ctx, `
+		UPDATE urls SET clicks = clicks + $2
+		WHERE short_id = $1 AND (expire_at = 0 OR expire_at > $3)
+		RETURNING clicks`,
+		shortID, n, time.Now().Unix()).Scan(&clicks)
+	if errors.Is(err, sql.ErrNoRows) {
+		// distinguish a missing row from an expired one
+		if _, err := p.Get(ctx, shortID); err != nil {
+			return 0, err
+		}
+		return 0, repository.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment clicks: %w", err)
+	}
+	return clicks, nil
+}
+
+func (p *PostgresStore) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+// NextSequence draws from the short_id_seq sequence.
+func (p *PostgresStore) NextSequence(ctx context.Context) (uint64, error) {
+	var n int64
+	if err := p.db.QueryRowContext(ctx, "SELECT nextval('short_id_seq')").Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to get next sequence value: %w", err)
+	}
+	return uint64(n), nil
+}
+
+func (p *PostgresStore) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO api_keys (key_id, name, key_hash, scopes, created_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (key_id) DO NOTHING`,
+		key.ID, key.Name, key.Hash, strings.Join(key.Scopes, " "), key.CreatedAt, key.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert api key: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (p *PostgresStore) GetAPIKey(ctx context.Context, id string) (*models.APIKey, error) {
+	var (
+		k      models.APIKey
+		scopes string
+	)
+	err := p.db.QueryRowContext(ctx, `
+		SELECT key_id, name, key_hash, scopes, created_at, revoked_at
+		FROM api_keys WHERE key_id = $1`, id).
+		Scan(&k.ID, &k.Name, &k.Hash, &scopes, &k.CreatedAt, &k.RevokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	k.Scopes = strings.Fields(scopes)
+	return &k, nil
+}
+
+func (p *PostgresStore) RevokeAPIKey(ctx context.Context, id string, at int64) error {
+	res, err := p.db.ExecContext(ctx, `
+		UPDATE api_keys
+		SET revoked_at = CASE WHEN revoked_at = 0 THEN $2 ELSE revoked_at END
+		WHERE key_id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// CreateWorkspace inserts the workspace and its first member in one
+// transaction.
+func (p *PostgresStore) CreateWorkspace(ctx context.Context, ws *models.Workspace, admin *models.Member) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO workspaces (workspace_id, name, created_at, created_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (workspace_id) DO NOTHING`,
+		ws.ID, ws.Name, ws.CreatedAt, ws.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert workspace: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO workspace_members (workspace_id, user_id, role, added_at)
+		VALUES ($1, $2, $3, $4)`,
+		admin.WorkspaceID, admin.UserID, string(admin.Role), admin.AddedAt); err != nil {
+		return fmt.Errorf("failed to insert member: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit workspace: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresStore) GetWorkspace(ctx context.Context, id string) (*models.Workspace, error) {
+	var ws models.Workspace
+	err := p.db.QueryRowContext(ctx, `
+		SELECT workspace_id, name, created_at, created_by
+		FROM workspaces WHERE workspace_id = $1`, id).
+		Scan(&ws.ID, &ws.Name, &ws.CreatedAt, &ws.CreatedBy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	return &ws, nil
+}
+
+// PutMember upserts a membership. The insert selects from workspaces so an
+// unknown workspace inserts nothing instead of violating the foreign key.
+func (p *PostgresStore) PutMember(ctx context.Context, m *models.Member) error {
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO workspace_members (workspace_id, user_id, role, added_at)
+		SELECT workspace_id, $2, $3, $4 FROM workspaces WHERE workspace_id = $1
+		ON CONFLICT (workspace_id, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		m.WorkspaceID, m.UserID, string(m.Role), m.AddedAt)
+	if err != nil {
+		return fmt.Errorf("failed to put member: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrWorkspaceNotFound
+	}
+	return nil
+}
+
+func (p *PostgresStore) GetMember(ctx context.Context, workspaceID, userID string) (*models.Member, error) {
+	var (
+		m    models.Member
+		role string
+	)
+	err := p.db.QueryRowContext(ctx, `
+		SELECT workspace_id, user_id, role, added_at
+		FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`, workspaceID, userID).
+		Scan(&m.WorkspaceID, &m.UserID, &role, &m.AddedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	m.Role = models.Role(role)
+	return &m, nil
+}
+
+func (p *PostgresStore) DeleteMember(ctx context.Context, workspaceID, userID string) error {
+	res, err := p.db.ExecContext(ctx,
+		"DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2", workspaceID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete member: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (p *PostgresStore) CreateDomain(ctx context.Context, d *models.Domain) error {
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO domains (name, verification_token, created_at, created_by, workspace_id, verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (name) DO NOTHING`,
+		d.Name, d.VerificationToken, d.CreatedAt, d.CreatedBy, d.WorkspaceID, d.VerifiedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert domain: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (p *PostgresStore) GetDomain(ctx context.Context, name string) (*models.Domain, error) {
+	var d models.Domain
+	err := p.db.QueryRowContext(ctx, `
+		SELECT name, verification_token, created_at, created_by, workspace_id, verified_at
+		FROM domains WHERE name = $1`, name).
+		Scan(&d.Name, &d.VerificationToken, &d.CreatedAt, &d.CreatedBy, &d.WorkspaceID, &d.VerifiedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrDomainNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain: %w", err)
+	}
+	return &d, nil
+}
+
+func (p *PostgresStore) VerifyDomain(ctx context.Context, name string, at int64) error {
+	res, err := p.db.ExecContext(ctx, `
+		UPDATE domains
+		SET verified_at = CASE WHEN verified_at = 0 THEN $2 ELSE verified_at END
+		WHERE name = $1`, name, at)
+	if err != nil {
+		return fmt.Errorf("failed to verify domain: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrDomainNotFound
+	}
+	return nil
+}
+
+// postgresDay parses a quota day for the DATE column of daily_creations.
+func postgresDay(day string) (time.Time, error) {
+	d, err := time.Parse(time.DateOnly, day)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid day %q: %w", day, err)
+	}
+	return d, nil
+}
+
+func (p *PostgresStore) DailyCreations(ctx context.Context, ownerID, day string) (int64, error) {
+	date, err := postgresDay(day)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	err = p.db.QueryRowContext(ctx,
+		"SELECT count FROM daily_creations WHERE owner_id = $1 AND day = $2", ownerID, date).Scan(&n)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get daily creations: %w", err)
+	}
+	return n, nil
+}
+
+// AddDailyCreation upserts the owner's row in one statement. The conflict
+// update is skipped when it would pass the limit, so no row is returned and
+// the count is read back for the error. It is skipped as well for a release
+// of a day the row has moved on from, which leaves the row alone.
+func (p *PostgresStore) AddDailyCreation(ctx context.Context, ownerID, day string, delta, limit int64) (int64, error) {
+	date, err := postgresDay(day)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	err = p.db.QueryRowContext(ctx, `
+		INSERT INTO daily_creations AS d (owner_id, day, count)
+		VALUES ($1, $2, GREATEST($3::bigint, 0))
+		ON CONFLICT (owner_id) DO UPDATE SET
+			count = GREATEST(CASE WHEN d.day = EXCLUDED.day THEN d.count ELSE 0 END + $3::bigint, 0),
+			day = EXCLUDED.day
+		WHERE ($3::bigint <= 0 OR $4::bigint <= 0
+			OR CASE WHEN d.day = EXCLUDED.day THEN d.count ELSE 0 END + $3::bigint <= $4::bigint)
+			AND ($3::bigint > 0 OR d.day = EXCLUDED.day)
+		RETURNING count`,
+		ownerID, date, delta, limit).Scan(&n)
+	if errors.Is(err, sql.ErrNoRows) {
+		n, err := p.DailyCreations(ctx, ownerID, day)
+		if err != nil {
+			return 0, err
+		}
+		if delta <= 0 {
+			return n, nil
+		}
+		return n, repository.ErrQuotaExceeded
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to add daily creation: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// newTestPostgresSchema creates an empty schema in the database at
+// POSTGRES_TEST_DSN, a postgres:// URL, and returns a DSN using it. The
+// test is skipped when the variable is not set.
+func newTestPostgresSchema(t *testing.T) string {
+	t.Helper()
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN is not set")
+	}
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("invalid POSTGRES_TEST_DSN: %v", err)
+	}
+
+	admin, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := "test_" + strings.ToLower(rand.Text()[:12])
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		admin.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		admin.Exec("DROP SCHEMA " + schema + " CASCADE")
+		admin.Close()
+	})
+
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func newTestPostgresStore(t *testing.T) *PostgresStore {
+	t.Helper()
+	store, err := NewPostgresStore(context.Background(), newTestPostgresSchema(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestPostgresMigrationsRunOnce(t *testing.T) {
+	dsn := newTestPostgresSchema(t)
+	migrations, err := loadPostgresMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Replicas starting together serialise on the advisory lock.
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			store, err := NewPostgresStore(context.Background(), dsn)
+			if err == nil {
+				store.Close()
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent migration = %v", err)
+		}
+	}
+
+	store, err := NewPostgresStore(context.Background(), dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	var n, latest int
+	if err := store.db.QueryRow("SELECT count(*), max(version) FROM schema_migrations").Scan(&n, &latest); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(migrations) || latest != migrations[len(migrations)-1].version {
+		t.Errorf("schema_migrations has %d versions up to %d, want %d up to %d",
+			n, latest, len(migrations), migrations[len(migrations)-1].version)
+	}
+}
+
+func TestPostgresCreateReplacesOnlyExpiredRows(t *testing.T) {
+	ctx := context.Background()
+	store := newTestPostgresStore(t)
+
+	live := &models.URL{ShortID: "abc", OriginalURL: "https://example.com/a", CreatedAt: time.Now(), OwnerID: "key:A"}
+	if err := store.Create(ctx, live); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Create(ctx, &models.URL{ShortID: "abc", OriginalURL: "https://example.com/b", CreatedAt: time.Now()}); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Fatalf("Create over a live row = %v, want ErrAlreadyExists", err)
+	}
+	got, err := store.Get(ctx, "abc")
+	if err != nil || got.OriginalURL != live.OriginalURL || got.OwnerID != "key:A" {
+		t.Fatalf("Get = %+v, %v", got, err)
+	}
+
+	expired := &models.URL{ShortID: "old", OriginalURL: "https://example.com/old", CreatedAt: time.Now(), ExpireAt: time.Now().Add(-time.Minute).Unix()}
+	if err := store.Create(ctx, expired); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.Get(ctx, "old"); !errors.Is(err, repository.ErrExpired) || got == nil {
+		t.Fatalf("Get of an expired row = %+v, %v, want it with ErrExpired", got, err)
+	}
+	reused := &models.URL{ShortID: "old", OriginalURL: "https://example.com/new", CreatedAt: time.Now()}
+	if err := store.Create(ctx, reused); err != nil {
+		t.Fatalf("Create over an expired row = %v", err)
+	}
+	if got, err := store.Get(ctx, "old"); err != nil || got.OriginalURL != reused.OriginalURL || got.ExpireAt != 0 {
+		t.Errorf("Get after reuse = %+v, %v", got, err)
+	}
+	if _, err := store.Get(ctx, "nope"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Get of an unknown id = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPostgresListPages(t *testing.T) {
+	ctx := context.Background()
+	store := newTestPostgresStore(t)
+	expired := time.Now().Add(-time.Minute).Unix()
+	for _, u := range []*models.URL{
+		{ShortID: "a", OwnerID: "key:A"},
+		{ShortID: "b", OwnerID: "key:B"},
+		{ShortID: "c", OwnerID: "key:A", ExpireAt: expired},
+		{ShortID: "d", OwnerID: "key:A"},
+		{ShortID: "e", OwnerID: "key:B"},
+	} {
+		u.OriginalURL, u.CreatedAt = "https://example.com/"+u.ShortID, time.Now()
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var pages [][]string
+	cursor := ""
+	for {
+		urls, next, err := store.List(ctx, 2, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, shortIDs(urls))
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if len(pages) != 2 || !slices.Equal(pages[0], []string{"a", "b"}) || !slices.Equal(pages[1], []string{"d", "e"}) {
+		t.Errorf("List pages = %v, want [[a b] [d e]]", pages)
+	}
+
+	mine, next, err := store.ListByOwner(ctx, "key:A", 1, "")
+	if err != nil || !slices.Equal(shortIDs(mine), []string{"a"}) || next != "a" {
+		t.Errorf("ListByOwner first page = %v, %q, %v", shortIDs(mine), next, err)
+	}
+	mine, next, _ = store.ListByOwner(ctx, "key:A", 1, next)
+	if !slices.Equal(shortIDs(mine), []string{"d"}) || next != "" {
+		t.Errorf("ListByOwner last page = %v, %q", shortIDs(mine), next)
+	}
+}
+
+func TestPostgresDailyCreations(t *testing.T) {
+	ctx := context.Background()
+	store := newTestPostgresStore(t)
+
+	for i := range 2 {
+		if _, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", 1, 2); err != nil {
+			t.Fatalf("creation %d = %v", i+1, err)
+		}
+	}
+	if n, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", 1, 2); !errors.Is(err, repository.ErrQuotaExceeded) || n != 2 {
+		t.Errorf("creation past the limit = %d, %v, want 2 and ErrQuotaExceeded", n, err)
+	}
+	if n, err := store.DailyCreations(ctx, "key:A", "2026-01-01"); err != nil || n != 2 {
+		t.Errorf("DailyCreations = %d, %v, want 2", n, err)
+	}
+	if n, err := store.AddDailyCreation(ctx, "key:A", "2026-01-02", 1, 2); err != nil || n != 1 {
+		t.Errorf("first creation of the next day = %d, %v, want 1", n, err)
+	}
+	// A release of a reservation made the day before comes in late.
+	if n, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", -1, 2); err != nil || n != 0 {
+		t.Errorf("late release = %d, %v, want 0 and no error", n, err)
+	}
+	if n, err := store.DailyCreations(ctx, "key:A", "2026-01-02"); err != nil || n != 1 {
+		t.Errorf("count of the next day after a late release = %d, %v, want 1", n, err)
+	}
+}
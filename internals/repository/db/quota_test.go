@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/repository"
+)
+
+// TestLateReleasesKeepTheNextDaysCount checks that releasing a reservation
+// after midnight UTC, once the count has moved on to the new day, leaves
+// the new day's count alone.
+func TestLateReleasesKeepTheNextDaysCount(t *testing.T) {
+	stores := map[string]repository.QuotaStore{
+		"memory": NewMemoryStore(),
+		"bolt":   newTestBoltStore(t),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if _, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", 1, 2); err != nil {
+				t.Fatal(err)
+			}
+			for i := range 2 {
+				if _, err := store.AddDailyCreation(ctx, "key:A", "2026-01-02", 1, 2); err != nil {
+					t.Fatalf("creation %d of the next day = %v", i+1, err)
+				}
+			}
+
+			if n, err := store.AddDailyCreation(ctx, "key:A", "2026-01-01", -1, 0); err != nil || n != 0 {
+				t.Errorf("late release = %d, %v, want 0 and no error", n, err)
+			}
+			if n, _ := store.DailyCreations(ctx, "key:A", "2026-01-02"); n != 2 {
+				t.Errorf("count of the next day after a late release = %d, want 2", n)
+			}
+			if _, err := store.AddDailyCreation(ctx, "key:A", "2026-01-02", 1, 2); err == nil {
+				t.Error("creation past the limit after a late release was allowed")
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/models"
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+)
+
+var (
+	// ErrNotFound is returned when no URL exists for the given short id.
+	ErrNotFound = utils.NotFound("url", "short_id not found")
+	// ErrAlreadyExists is returned by Create when the short id is taken.
+	ErrAlreadyExists = utils.AlreadyExists("url", "short_id already exists")
+	// ErrExpired is returned when the URL exists but its expire_at has
+	// passed.
+	ErrExpired = utils.FailedPrecondition("EXPIRED", "url", "short_id has expired")
+	// ErrAPIKeyNotFound is returned when no API key exists for the given
+	// key id.
+	ErrAPIKeyNotFound = utils.NotFound("api_key", "api key not found")
+	// ErrWorkspaceNotFound is returned when no workspace exists for the
+	// given id.
+	ErrWorkspaceNotFound = utils.NotFound("workspace", "workspace not found")
+	// ErrMemberNotFound is returned when a user is not a member of the
+	// workspace.
+	ErrMemberNotFound = utils.NotFound("workspace_member", "workspace member not found")
+	// ErrDomainNotFound is returned when a custom domain is not
+	// registered.
+	ErrDomainNotFound = utils.NotFound("domain", "domain not found")
+	// ErrQuotaExceeded is returned by AddDailyCreation when the count has
+	// reached its limit.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// URLStore is the storage abstraction used by the gRPC handlers and the
+// redirect server. Implementations must be safe for concurrent use.
+type URLStore interface {
+	// Create stores a new URL. It returns ErrAlreadyExists if the short id
+	// is already in use.
+	Create(ctx context.Context, url *models.URL) error
+
+	// Get returns the URL stored under shortID or ErrNotFound. URLs past
+	// their expiry are returned together with ErrExpired, so that callers
+	// can still tell who they belong to.
+	Get(ctx context.Context, shortID string) (*models.URL, error)
+
+	// Update applies a partial update to an existing URL or returns
+	// ErrNotFound. Expired URLs can be updated, and so revived, until the
+	// backend purges them.
+	Update(ctx context.Context, shortID string, upd models.URLUpdate) error
+
+	// Delete removes a URL, expired or not, or returns ErrNotFound.
+	Delete(ctx context.Context, shortID string) error
+
+	// List returns up to limit URLs starting after cursor, along with the
+	// cursor for the next page. An empty next cursor means there are no
+	// more results.
+	List(ctx context.Context, limit int32, cursor string) ([]*models.URL, string, error)
+
+	// ListByOwner pages through the live URLs owned by ownerID the same way
+	// as List, using an owner index rather than a full scan.
+	ListByOwner(ctx context.Context, ownerID string, limit int32, cursor string) ([]*models.URL, string, error)
+
+	// ListByWorkspace pages through the live URLs of a workspace the same
+	// way, using a workspace index.
+	ListByWorkspace(ctx context.Context, workspaceID string, limit int32, cursor string) ([]*models.URL, string, error)
+
+	// CountByOwner returns the number of live URLs owned by ownerID.
+	CountByOwner(ctx context.Context, ownerID string) (int64, error)
+
+	// IncrementClicks adds n to the click counter of an existing URL and
+	// returns the new total.
+	IncrementClicks(ctx context.Context, shortID string, n int64) (int64, error)
+
+	// Ping verifies that the backend is reachable.
+	Ping(ctx context.Context) error
+}
+
+// APIKeyStore persists API keys for the gRPC management API. Only key
+// hashes are stored.
+type APIKeyStore interface {
+	// CreateAPIKey stores a new key. It returns ErrAlreadyExists if the key
+	// id is already in use.
+	CreateAPIKey(ctx context.Context, key *models.APIKey) error
+
+	// GetAPIKey returns the key stored under id, revoked or not, or
+	// ErrAPIKeyNotFound.
+	GetAPIKey(ctx context.Context, id string) (*models.APIKey, error)
+
+	// RevokeAPIKey marks a key as revoked at the given unix time or returns
+	// ErrAPIKeyNotFound. Revoking a revoked key keeps the original time.
+	RevokeAPIKey(ctx context.Context, id string, at int64) error
+}
+
+// WorkspaceStore persists workspaces and their members.
+type WorkspaceStore interface {
+	// CreateWorkspace stores a new workspace together with its first
+	// member. It returns ErrAlreadyExists if the id is taken.
+	CreateWorkspace(ctx context.Context, ws *models.Workspace, admin *models.Member) error
+
+	// GetWorkspace returns the workspace or ErrWorkspaceNotFound.
+	GetWorkspace(ctx context.Context, id string) (*models.Workspace, error)
+
+	// PutMember adds a member or changes the role of an existing one. It
+	// returns ErrWorkspaceNotFound if the workspace does not exist.
+	PutMember(ctx context.Context, m *models.Member) error
+
+	// GetMember returns a membership or ErrMemberNotFound.
+	GetMember(ctx context.Context, workspaceID, userID string) (*models.Member, error)
+
+	// DeleteMember removes a membership or returns ErrMemberNotFound.
+	DeleteMember(ctx context.Context, workspaceID, userID string) error
+}
+
+// DomainStore persists the custom domains links can be bound to.
+type DomainStore interface {
+	// CreateDomain registers a domain. It returns ErrAlreadyExists if the
+	// name is taken.
+	CreateDomain(ctx context.Context, d *models.Domain) error
+
+	// GetDomain returns the domain or ErrDomainNotFound.
+	GetDomain(ctx context.Context, name string) (*models.Domain, error)
+
+	// VerifyDomain marks a domain as verified at the given unix time or
+	// returns ErrDomainNotFound. Verifying again keeps the original time.
+	VerifyDomain(ctx context.Context, name string, at int64) error
+}
+
+// LegacyExpiryStore is implemented by stores that may hold links from
+// versions that did not enforce expiry, which stored expire_at equal to the
+// creation time for links created without one.
+type LegacyExpiryStore interface {
+	// ClearLegacyExpiry makes those links never expire and returns how many
+	// it changed. Running it again is a no-op.
+	ClearLegacyExpiry(ctx context.Context) (int64, error)
+}
+
+// QuotaStore keeps, for every owner, a count of the links created during
+// the current day. Days are UTC dates formatted as 2006-01-02; each owner
+// only has a count for its latest day.
+type QuotaStore interface {
+	// DailyCreations returns how many links ownerID created on day.
+	DailyCreations(ctx context.Context, ownerID, day string) (int64, error)
+
+	// AddDailyCreation adds delta to the count of ownerID for day, starting
+	// from zero if the stored count is for an earlier day, and returns the
+	// new count. An increase past a positive limit is refused with
+	// ErrQuotaExceeded and the current count, and changes nothing. Counts
+	// never go below zero, and a decrease for a day other than the stored
+	// one changes nothing, so that releasing a reservation made before
+	// midnight cannot reset the new day's count.
+	AddDailyCreation(ctx context.Context, ownerID, day string, delta, limit int64) (int64, error)
+}
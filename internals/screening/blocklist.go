@@ -0,0 +1,200 @@
+package screening
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aayushxrj/aws-url-shortner/internals/validation"
+)
+
+// Blocklist is a DestinationChecker backed by a local file. Each line holds
+// one rule:
+//
+//	# comment
+//	evil.example                  block the domain and its subdomains
+//	bücher.example                same as xn--bcher-kva.example
+//	regex:^https?://[^/]+\.zip/   block URLs matching the expression
+//	flag:odd.example              flag instead of block
+//	flag:regex:/login\.php        flag URLs matching the expression
+//
+// Watch reloads the file when it changes, so rules can be updated without a
+// restart.
+type Blocklist struct {
+	path string
+
+	mu      sync.RWMutex
+	rules   *ruleSet
+	modTime time.Time
+}
+
+type ruleSet struct {
+	blockedDomains map[string]bool
+	flaggedDomains map[string]bool
+	blockedRegexps []*regexp.Regexp
+	flaggedRegexps []*regexp.Regexp
+}
+
+// NewBlocklist loads the rules in path.
+func NewBlocklist(path string) (*Blocklist, error) {
+	b := &Blocklist{path: path}
+	if err := b.Reload(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Reload re-reads the rules file. On error the previous rules stay active.
+func (b *Blocklist) Reload() error {
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat blocklist: %w", err)
+	}
+	rules, err := loadRules(b.path)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.rules, b.modTime = rules, info.ModTime()
+	b.mu.Unlock()
+	return nil
+}
+
+// Watch polls the rules file every interval and reloads it when its
+// modification time changes, until ctx is done.
+func (b *Blocklist) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(b.path)
+		if err != nil {
+			log.Printf("blocklist watch: %v", err)
+			continue
+		}
+		b.mu.RLock()
+		changed := !info.ModTime().Equal(b.modTime)
+		b.mu.RUnlock()
+		if !changed {
+			continue
+		}
+
+		if err := b.Reload(); err != nil {
+			log.Printf("blocklist reload failed, keeping previous rules: %v", err)
+			continue
+		}
+		log.Printf("blocklist reloaded from %s", b.path)
+	}
+}
+
+func loadRules(path string) (*ruleSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blocklist: %w", err)
+	}
+	defer f.Close()
+
+	rules := &ruleSet{
+		blockedDomains: map[string]bool{},
+		flaggedDomains: map[string]bool{},
+	}
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		flag := false
+		if rest, ok := strings.CutPrefix(line, "flag:"); ok {
+			flag, line = true, rest
+		}
+
+		if expr, ok := strings.CutPrefix(line, "regex:"); ok {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNo, err)
+			}
+			if flag {
+				rules.flaggedRegexps = append(rules.flaggedRegexps, re)
+			} else {
+				rules.blockedRegexps = append(rules.blockedRegexps, re)
+			}
+			continue
+		}
+
+		domain, err := validation.NormalizeHost(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid domain %q: %w", path, lineNo, line, err)
+		}
+		if flag {
+			rules.flaggedDomains[domain] = true
+		} else {
+			rules.blockedDomains[domain] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read blocklist: %w", err)
+	}
+	return rules, nil
+}
+
+func (b *Blocklist) Check(ctx context.Context, rawURL string) (Verdict, error) {
+	b.mu.RLock()
+	rules := b.rules
+	b.mu.RUnlock()
+
+	var host string
+	if u, err := url.Parse(rawURL); err == nil {
+		if host, err = validation.NormalizeHost(u.Hostname()); err != nil {
+			host = strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+		}
+	}
+
+	if matchDomain(rules.blockedDomains, host) {
+		return Verdict{Blocked: true, Reason: "destination domain is blocklisted"}, nil
+	}
+	for _, re := range rules.blockedRegexps {
+		if re.MatchString(rawURL) {
+			return Verdict{Blocked: true, Reason: "destination matches a blocklist rule"}, nil
+		}
+	}
+	if matchDomain(rules.flaggedDomains, host) {
+		return Verdict{Flagged: true, Reason: "destination domain is on the watch list"}, nil
+	}
+	for _, re := range rules.flaggedRegexps {
+		if re.MatchString(rawURL) {
+			return Verdict{Flagged: true, Reason: "destination matches a watch list rule"}, nil
+		}
+	}
+	return Verdict{}, nil
+}
+
+// matchDomain reports whether host or any of its parent domains is in set.
+func matchDomain(set map[string]bool, host string) bool {
+	for host != "" {
+		if set[host] {
+			return true
+		}
+		_, parent, ok := strings.Cut(host, ".")
+		if !ok {
+			return false
+		}
+		host = parent
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package screening
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestBlocklist(t *testing.T, rules ...string) *Blocklist {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(rules, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewBlocklist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestBlocklistCheck(t *testing.T) {
+	b := newTestBlocklist(t,
+		"# comment",
+		"Evil.Example.",
+		"bücher.example",
+		"regex:\\.zip/",
+		"flag:odd.example",
+		"flag:regex:/login\\.php$",
+	)
+
+	tests := []struct {
+		url     string
+		blocked bool
+		flagged bool
+	}{
+		{"https://evil.example/", true, false},
+		{"https://www.EVIL.example./x", true, false},
+		{"https://notevil.example/", false, false},
+		{"https://xn--bcher-kva.example/", true, false},
+		{"https://shop.bücher.example/", true, false},
+		{"https://files.example.zip/a", true, false},
+		{"https://odd.example/", false, true},
+		{"https://a.odd.example/", false, true},
+		{"https://safe.example/login.php", false, true},
+		{"https://safe.example/", false, false},
+	}
+	for _, tt := range tests {
+		v, err := b.Check(context.Background(), tt.url)
+		if err != nil {
+			t.Fatalf("Check(%q) = %v", tt.url, err)
+		}
+		if v.Blocked != tt.blocked || v.Flagged != tt.flagged {
+			t.Errorf("Check(%q) = %+v, want blocked=%v flagged=%v", tt.url, v, tt.blocked, tt.flagged)
+		}
+	}
+}
+
+func TestBlocklistRejectsInvalidRules(t *testing.T) {
+	for _, rule := range []string{"regex:(", "evil example", "evil_example.com"} {
+		path := filepath.Join(t.TempDir(), "blocklist.txt")
+		if err := os.WriteFile(path, []byte(rule), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewBlocklist(path); err == nil {
+			t.Errorf("NewBlocklist accepted %q", rule)
+		}
+	}
+}
+
+func TestBlocklistReloadKeepsRulesOnError(t *testing.T) {
+	b := newTestBlocklist(t, "evil.example")
+	if err := os.WriteFile(b.path, []byte("regex:("), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Reload(); err == nil {
+		t.Fatal("Reload accepted an invalid rule")
+	}
+	v, _ := b.Check(context.Background(), "https://evil.example/")
+	if !v.Blocked {
+		t.Error("previous rules were dropped after a failed reload")
+	}
+}
@@ -0,0 +1,56 @@
+package screening
+
+import (
+	"context"
+	"errors"
+	"log"
+)
+
+// ErrBlocked is returned when a destination is refused by screening.
+var ErrBlocked = errors.New("destination is blocked")
+
+// Verdict is the outcome of screening a destination URL.
+type Verdict struct {
+	// Blocked destinations must not be shortened or redirected to.
+	Blocked bool
+	// Flagged destinations are allowed, but visitors see a warning page
+	// before being sent on.
+	Flagged bool
+	// Reason is a short, user presentable explanation.
+	Reason string
+}
+
+// DestinationChecker screens destination URLs. Local blocklists and
+// external reputation services both implement it.
+type DestinationChecker interface {
+	Check(ctx context.Context, rawURL string) (Verdict, error)
+}
+
+// Chain runs several checkers and merges their verdicts: any block wins,
+// otherwise any flag wins. A checker that errors is logged and skipped,
+// unless FailClosed is set, in which case the error is returned.
+type Chain struct {
+	Checkers   []DestinationChecker
+	FailClosed bool
+}
+
+func (c *Chain) Check(ctx context.Context, rawURL string) (Verdict, error) {
+	var merged Verdict
+	for _, checker := range c.Checkers {
+		v, err := checker.Check(ctx, rawURL)
+		if err != nil {
+			if c.FailClosed {
+				return Verdict{}, err
+			}
+			log.Printf("destination check failed for %s: %v", rawURL, err)
+			continue
+		}
+		if v.Blocked {
+			return v, nil
+		}
+		if v.Flagged && !merged.Flagged {
+			merged = v
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,186 @@
+// Package tlsconfig builds server TLS configurations whose certificate and
+// client CAs are reloaded from disk when the files change, so certificates
+// can be renewed without a restart.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Files are the PEM files a server's TLS configuration is loaded from.
+type Files struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile, when set, requires clients to present a certificate
+	// issued by one of the CAs it holds.
+	ClientCAFile string
+}
+
+// Certificates holds the current server certificate and client CA pool.
+type Certificates struct {
+	files Files
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// Load reads the files named by files.
+func Load(files Files) (*Certificates, error) {
+	if files.CertFile == "" || files.KeyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required")
+	}
+	c := &Certificates{files: files}
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Certificates) paths() []string {
+	paths := []string{c.files.CertFile, c.files.KeyFile}
+	if c.files.ClientCAFile != "" {
+		paths = append(paths, c.files.ClientCAFile)
+	}
+	return paths
+}
+
+// Reload reads the files again, keeping the previous certificates if any of
+// them is invalid.
+func (c *Certificates) Reload() error {
+	modTimes := make(map[string]time.Time)
+	for _, path := range c.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.files.CertFile, c.files.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if c.files.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.files.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client CA file %s", c.files.ClientCAFile)
+		}
+	}
+
+	c.mu.Lock()
+	c.cert, c.clientCAs, c.modTimes = &cert, pool, modTimes
+	c.mu.Unlock()
+	return nil
+}
+
+// Watch polls the files every interval and reloads them when a
+// modification time changes, until ctx is done. Certificates without files
+// are never reloaded.
+func (c *Certificates) Watch(ctx context.Context, interval time.Duration) {
+	if c.files.CertFile == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !c.changed() {
+			continue
+		}
+		if err := c.Reload(); err != nil {
+			log.Printf("tls reload failed, keeping previous certificate: %v", err)
+			continue
+		}
+		log.Printf("tls certificate reloaded from %s", c.files.CertFile)
+	}
+}
+
+// changed reports whether any file has a new modification time.
+func (c *Certificates) changed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, path := range c.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			log.Printf("tls watch: %v", err)
+			return false
+		}
+		if !info.ModTime().Equal(c.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServerConfig returns a server configuration serving the current
+// certificate and, with a client CA file, requiring client certificates
+// signed by the current CAs.
+func (c *Certificates) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			return c.cert, nil
+		},
+	}
+	if c.files.ClientCAFile != "" {
+		// Verification is done against the reloadable pool rather than
+		// ClientCAs, which cannot change once the config is in use.
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = c.verifyClient
+	}
+	return cfg
+}
+
+func (c *Certificates) verifyClient(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+		certs[i] = cert
+	}
+	if len(certs) == 0 {
+		return errors.New("client certificate required")
+	}
+
+	c.mu.RLock()
+	roots := c.clientCAs
+	c.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
@@ -0,0 +1,186 @@
+package tlsconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newCert returns a certificate for name with the given usage, signed by
+// parent or, if parent is nil, self-signed as a CA.
+func newCert(t *testing.T, name string, usage x509.ExtKeyUsage, parent *tls.Certificate) *tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	signer, signerKey := template, any(key)
+	if parent == nil {
+		template.IsCA, template.BasicConstraintsValid = true, true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// writeCert writes cert and its key as PEM to certFile and keyFile, with a
+// modification time of at.
+func writeCert(t *testing.T, cert *tls.Certificate, certFile, keyFile string, at time.Time) {
+	t.Helper()
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		certFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}),
+		keyFile:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}),
+	}
+	for path, data := range files {
+		if path == "" {
+			continue
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func currentCert(t *testing.T, cfg *tls.Config) []byte {
+	t.Helper()
+	cert, err := cfg.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Certificate[0]
+}
+
+func TestWatchServesSwappedCertificates(t *testing.T) {
+	dir := t.TempDir()
+	files := Files{CertFile: filepath.Join(dir, "tls.crt"), KeyFile: filepath.Join(dir, "tls.key")}
+	old := newCert(t, "old.example", x509.ExtKeyUsageServerAuth, nil)
+	writeCert(t, old, files.CertFile, files.KeyFile, time.Now().Add(-time.Minute))
+
+	certs, err := Load(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := certs.ServerConfig()
+	if !bytes.Equal(currentCert(t, cfg), old.Certificate[0]) {
+		t.Fatal("GetCertificate does not return the loaded certificate")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go certs.Watch(ctx, 10*time.Millisecond)
+
+	renewed := newCert(t, "renewed.example", x509.ExtKeyUsageServerAuth, nil)
+	writeCert(t, renewed, files.CertFile, files.KeyFile, time.Now())
+	deadline := time.Now().Add(5 * time.Second)
+	for !bytes.Equal(currentCert(t, cfg), renewed.Certificate[0]) {
+		if time.Now().After(deadline) {
+			t.Fatal("GetCertificate still returns the old certificate")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// A broken replacement keeps the renewed certificate in use.
+	if err := os.WriteFile(files.CertFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := certs.Reload(); err == nil {
+		t.Error("Reload accepted an invalid certificate")
+	}
+	if !bytes.Equal(currentCert(t, cfg), renewed.Certificate[0]) {
+		t.Error("an invalid certificate replaced the renewed one")
+	}
+}
+
+func TestServerConfigRequiresClientCertificates(t *testing.T) {
+	dir := t.TempDir()
+	files := Files{
+		CertFile:     filepath.Join(dir, "tls.crt"),
+		KeyFile:      filepath.Join(dir, "tls.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+	writeCert(t, newCert(t, "server.example", x509.ExtKeyUsageServerAuth, nil), files.CertFile, files.KeyFile, time.Now())
+	ca := newCert(t, "clients", x509.ExtKeyUsageClientAuth, nil)
+	writeCert(t, ca, files.ClientCAFile, "", time.Now())
+
+	certs, err := Load(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", certs.ServerConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	// handshake connects with the given client certificates and returns
+	// the server's handshake error.
+	handshake := func(clientCerts ...tls.Certificate) error {
+		serverErr := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				serverErr <- err
+				return
+			}
+			defer conn.Close()
+			serverErr <- conn.(*tls.Conn).Handshake()
+		}()
+		conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       clientCerts,
+		})
+		if err == nil {
+			// TLS 1.3 clients finish before the server checks them.
+			conn.Read(make([]byte, 1))
+			conn.Close()
+		}
+		return <-serverErr
+	}
+
+	if err := handshake(); err == nil {
+		t.Error("a client without a certificate was accepted")
+	}
+	stranger := newCert(t, "stranger", x509.ExtKeyUsageClientAuth, nil)
+	if err := handshake(*stranger); err == nil {
+		t.Error("a client certificate from another CA was accepted")
+	}
+	if err := handshake(*newCert(t, "client", x509.ExtKeyUsageClientAuth, ca)); err != nil {
+		t.Errorf("a client certificate from the client CA was rejected: %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/aayushxrj/aws-url-shortner/pkg/utils"
+	"golang.org/x/net/idna"
+)
+
+// DefaultMaxURLLength is the longest destination accepted by default.
+// Browsers and proxies start to misbehave beyond roughly 2 KB.
+const DefaultMaxURLLength = 2048
+
+// URLValidator checks and normalises destination URLs before they are
+// stored. The zero value allows http and https URLs up to
+// DefaultMaxURLLength characters.
+type URLValidator struct {
+	// AllowedSchemes lists the permitted schemes; http and https when empty.
+	AllowedSchemes []string
+	// MaxLength caps the normalised URL length; DefaultMaxURLLength when zero.
+	MaxLength int
+	// SelfHosts are the hosts the shortener itself is served from. Links
+	// pointing back at them would redirect in a loop. An entry with a port
+	// only matches that port, one without matches any port.
+	SelfHosts []string
+}
+
+// lookup is the IDNA profile used to turn internationalised host names into
+// their punycode form.
+var lookup = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(false))
+
+// NormalizeHost returns a domain name in the form destination hosts are
+// stored in: lower case, punycode encoded, without a trailing dot. Anything
+// else matching hosts against stored links, such as blocklists and custom
+// domains, must use it too so that every spelling of a host compares equal.
+// An empty name stays empty.
+func NormalizeHost(name string) (string, error) {
+	ascii, err := lookup.ToASCII(strings.TrimSuffix(name, "."))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(ascii), nil
+}
+
+// Normalize validates raw as a redirect destination and returns it in
+// canonical form: lower-case scheme and host, IDN hosts punycode encoded.
+// Failures are reported as utils.FieldError for field.
+func (v *URLValidator) Normalize(field, raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", utils.InvalidArgument(field, "is required")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", utils.InvalidArgument(field, "is not a valid URL")
+	}
+	if !u.IsAbs() {
+		return "", utils.InvalidArgument(field, "must be an absolute URL including the scheme")
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if !v.schemeAllowed(u.Scheme) {
+		return "", utils.InvalidArgument(field, "scheme "+u.Scheme+" is not allowed")
+	}
+	if u.Opaque != "" || u.Hostname() == "" {
+		return "", utils.InvalidArgument(field, "must include a host")
+	}
+	if u.User != nil {
+		// http://trusted.example@evil.example is a classic phishing trick
+		return "", utils.InvalidArgument(field, "must not contain credentials")
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		host = ip.String()
+	} else if host, err = NormalizeHost(host); err != nil || host == "" {
+		return "", utils.InvalidArgument(field, "has an invalid host name")
+	}
+	if port := u.Port(); port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+
+	if v.isSelf(host, u.Port()) {
+		return "", utils.InvalidArgument(field, "must not point at the shortener itself")
+	}
+
+	normalized := u.String()
+	maxLength := v.MaxLength
+	if maxLength <= 0 {
+		maxLength = DefaultMaxURLLength
+	}
+	if len(normalized) > maxLength {
+		return "", utils.InvalidArgument(field, "is longer than the allowed maximum")
+	}
+	return normalized, nil
+}
+
+func (v *URLValidator) schemeAllowed(scheme string) bool {
+	allowed := v.AllowedSchemes
+	if len(allowed) == 0 {
+		allowed = []string{"http", "https"}
+	}
+	for _, s := range allowed {
+		if strings.EqualFold(s, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *URLValidator) isSelf(host, port string) bool {
+	for _, self := range v.SelfHosts {
+		selfHost, selfPort, err := net.SplitHostPort(self)
+		if err != nil {
+			selfHost, selfPort = self, ""
+		}
+		if !strings.EqualFold(selfHost, host) {
+			continue
+		}
+		if selfPort == "" || selfPort == port {
+			return true
+		}
+	}
+	return false
+}
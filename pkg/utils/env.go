@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetEnv returns the value of key, or fallback when it is unset or empty.
+func GetEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// GetEnvInt parses key as an integer, falling back when it is unset or
+// malformed.
+func GetEnvInt(key string, fallback int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		log.Printf("⚠️ Warning: invalid %s=%q, using %d", key, v, fallback)
+		return fallback
+	}
+	return n
+}
+
+// GetEnvDuration parses key as a time.Duration (e.g. "30s"), falling back
+// when it is unset or malformed.
+func GetEnvDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("⚠️ Warning: invalid %s=%q, using %s", key, v, fallback)
+		return fallback
+	}
+	return d
+}
+
+// GetEnvList splits the comma separated value of key, dropping blank
+// entries, or returns fallback when it is unset or empty.
+func GetEnvList(key string, fallback []string) []string {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// GetEnvBool parses key as a boolean ("true", "1", ...), falling back when
+// it is unset or malformed.
+func GetEnvBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("⚠️ Warning: invalid %s=%q, using %t", key, v, fallback)
+		return fallback
+	}
+	return b
+}
@@ -1 +1,162 @@
-package utils
\ No newline at end of file
+package utils
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// StatusError is implemented by domain errors that know their gRPC status:
+// the repository sentinels, rate limit and quota errors. Their Error text
+// must be safe to show to callers.
+type StatusError interface {
+	error
+	StatusCode() codes.Code
+	StatusDetails() []protoadapt.MessageV1
+}
+
+// DomainError is a StatusError with a fixed code and details, used for
+// sentinel errors.
+type DomainError struct {
+	Code    codes.Code
+	Message string
+	Details []protoadapt.MessageV1
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func (e *DomainError) StatusCode() codes.Code {
+	return e.Code
+}
+
+func (e *DomainError) StatusDetails() []protoadapt.MessageV1 {
+	return e.Details
+}
+
+// NotFound returns a DomainError for a missing resource of resourceType.
+func NotFound(resourceType, message string) error {
+	return &DomainError{Code: codes.NotFound, Message: message, Details: []protoadapt.MessageV1{
+		&errdetails.ResourceInfo{ResourceType: resourceType, Description: message},
+	}}
+}
+
+// AlreadyExists returns a DomainError for a resource of resourceType that
+// is already taken.
+func AlreadyExists(resourceType, message string) error {
+	return &DomainError{Code: codes.AlreadyExists, Message: message, Details: []protoadapt.MessageV1{
+		&errdetails.ResourceInfo{ResourceType: resourceType, Description: message},
+	}}
+}
+
+// FailedPrecondition returns a DomainError for a subject that is in the
+// wrong state, violationType naming the state (e.g. "EXPIRED").
+func FailedPrecondition(violationType, subject, message string) error {
+	return &DomainError{Code: codes.FailedPrecondition, Message: message, Details: []protoadapt.MessageV1{
+		&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: message},
+		}},
+	}}
+}
+
+// throttlingCodes are AWS error codes that mean "slow down and retry".
+var throttlingCodes = map[string]bool{
+	"ProvisionedThroughputExceededException": true,
+	"ThrottlingException":                    true,
+	"RequestLimitExceeded":                   true,
+	"LimitExceededException":                 true,
+}
+
+// unavailableCodes are AWS error codes for transient service failures.
+var unavailableCodes = map[string]bool{
+	"InternalServerError":          true,
+	"ServiceUnavailable":           true,
+	"TransactionConflictException": true,
+}
+
+// ErrorHandler converts err into a gRPC status error with a canonical code.
+// message is a client safe description of what failed; field and domain
+// errors append their own (safe) text to it, while backend errors are
+// logged and replaced by message alone so raw AWS or database errors never
+// reach callers. Errors that already carry a gRPC status are returned
+// unchanged.
+func ErrorHandler(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var fieldErrs FieldErrors
+	if errors.As(err, &fieldErrs) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(fieldErrs))
+		for i, fe := range fieldErrs {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: fe.Field, Description: fe.Description}
+		}
+		return withDetails(codes.InvalidArgument, fieldErrs.Error(), &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return withDetails(codes.InvalidArgument, fieldErr.Error(), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fieldErr.Field, Description: fieldErr.Description},
+			},
+		})
+	}
+
+	var domainErr StatusError
+	if errors.As(err, &domainErr) {
+		return withDetails(domainErr.StatusCode(), message+": "+domainErr.Error(), domainErr.StatusDetails()...)
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, message)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, message)
+	}
+
+	log.Printf("%s: %v", message, err)
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case throttlingCodes[apiErr.ErrorCode()]:
+			return withDetails(codes.ResourceExhausted, message, &errdetails.RetryInfo{
+				RetryDelay: durationpb.New(time.Second),
+			})
+		case unavailableCodes[apiErr.ErrorCode()]:
+			return status.Error(codes.Unavailable, message)
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return status.Error(codes.Unavailable, message)
+	}
+
+	return status.Error(codes.Internal, message)
+}
+
+// withDetails builds a status error carrying the given errdetails payloads.
+func withDetails(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	if len(details) > 0 {
+		if detailed, err := st.WithDetails(details...); err == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
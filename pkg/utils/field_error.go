@@ -0,0 +1,31 @@
+package utils
+
+import "strings"
+
+// FieldError is a validation failure on a single request field. The API
+// handlers report it as InvalidArgument with a BadRequest field violation.
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Description
+}
+
+// InvalidArgument returns a FieldError for field.
+func InvalidArgument(field, description string) error {
+	return &FieldError{Field: field, Description: description}
+}
+
+// FieldErrors collects several field validation failures. The API handlers
+// report them as a single InvalidArgument with one violation per field.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -7,6 +7,7 @@
 package mainpb
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,8 +26,16 @@ type ShortenURLRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	OriginalUrl     string                 `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
 	ExpireInSeconds int64                  `protobuf:"varint,2,opt,name=expire_in_seconds,json=expireInSeconds,proto3" json:"expire_in_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional vanity slug, e.g. "launch-2026"
+	CustomAlias string `protobuf:"bytes,3,opt,name=custom_alias,json=customAlias,proto3" json:"custom_alias,omitempty"`
+	// Optional absolute expiry (unix seconds), instead of expire_in_seconds
+	ExpireAt int64 `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Optional workspace to create the link in (editor role), personal if empty
+	WorkspaceId string `protobuf:"bytes,5,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// Optional verified custom domain to create the link on, the primary domain if empty
+	Domain        string `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShortenURLRequest) Reset() {
@@ -73,12 +82,43 @@ func (x *ShortenURLRequest) GetExpireInSeconds() int64 {
 	return 0
 }
 
+func (x *ShortenURLRequest) GetCustomAlias() string {
+	if x != nil {
+		return x.CustomAlias
+	}
+	return ""
+}
+
+func (x *ShortenURLRequest) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *ShortenURLRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ShortenURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 type ShortenURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortId       string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	ShortId       string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"` // "<domain>/<id>" for links on a custom domain
 	ShortUrl      string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpireAt      int64                  `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	ExpireAt      int64                  `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // 0 if the link never expires
+	Flagged       bool                   `protobuf:"varint,5,opt,name=flagged,proto3" json:"flagged,omitempty"`                   // Destination screening flagged the link as suspicious
+	WorkspaceId   string                 `protobuf:"bytes,6,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain,omitempty"` // Empty on the primary domain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +181,27 @@ func (x *ShortenURLResponse) GetExpireAt() int64 {
 	return 0
 }
 
+func (x *ShortenURLResponse) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *ShortenURLResponse) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ShortenURLResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 type GetOriginalURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShortId       string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
@@ -450,6 +511,10 @@ type GetURLStatsResponse struct {
 	Clicks        int64                  `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ExpireAt      int64                  `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	Flagged       bool                   `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,8,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,9,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -519,14 +584,45 @@ func (x *GetURLStatsResponse) GetExpireAt() int64 {
 	return 0
 }
 
+func (x *GetURLStatsResponse) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *GetURLStatsResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetURLStatsResponse) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *GetURLStatsResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 // UpdateURL
 type UpdateURLRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	ShortId            string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
 	NewOriginalUrl     string                 `protobuf:"bytes,2,opt,name=new_original_url,json=newOriginalUrl,proto3" json:"new_original_url,omitempty"`
 	NewExpireInSeconds int64                  `protobuf:"varint,3,opt,name=new_expire_in_seconds,json=newExpireInSeconds,proto3" json:"new_expire_in_seconds,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Absolute expiry (unix seconds), instead of new_expire_in_seconds
+	NewExpireAt   int64 `protobuf:"varint,4,opt,name=new_expire_at,json=newExpireAt,proto3" json:"new_expire_at,omitempty"`
+	ClearExpiry   bool  `protobuf:"varint,5,opt,name=clear_expiry,json=clearExpiry,proto3" json:"clear_expiry,omitempty"` // Make the link never expire
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateURLRequest) Reset() {
@@ -580,6 +676,20 @@ func (x *UpdateURLRequest) GetNewExpireInSeconds() int64 {
 	return 0
 }
 
+func (x *UpdateURLRequest) GetNewExpireAt() int64 {
+	if x != nil {
+		return x.NewExpireAt
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetClearExpiry() bool {
+	if x != nil {
+		return x.ClearExpiry
+	}
+	return false
+}
+
 type UpdateURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -732,7 +842,7 @@ func (x *DeleteURLResponse) GetMessage() string {
 // ListAllURLs
 type ListAllURLsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Limit            int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit            int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                                                // 0 means the backend default
 	LastEvaluatedKey string                 `protobuf:"bytes,2,opt,name=last_evaluated_key,json=lastEvaluatedKey,proto3" json:"last_evaluated_key,omitempty"` // Optional for pagination
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
@@ -834,21 +944,139 @@ func (x *ListAllURLsResponse) GetLastEvaluatedKey() string {
 	return ""
 }
 
+// ListMyURLs
+type ListMyURLsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Limit            int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                                                // 0 means the backend default
+	LastEvaluatedKey string                 `protobuf:"bytes,2,opt,name=last_evaluated_key,json=lastEvaluatedKey,proto3" json:"last_evaluated_key,omitempty"` // Optional for pagination
+	// Optional; lists the workspace's links (viewer role) instead of the caller's own
+	WorkspaceId   string `protobuf:"bytes,3,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyURLsRequest) Reset() {
+	*x = ListMyURLsRequest{}
+	mi := &file_main_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyURLsRequest) ProtoMessage() {}
+
+func (x *ListMyURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyURLsRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMyURLsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMyURLsRequest) GetLastEvaluatedKey() string {
+	if x != nil {
+		return x.LastEvaluatedKey
+	}
+	return ""
+}
+
+func (x *ListMyURLsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListMyURLsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Urls             []*UrlItem             `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	LastEvaluatedKey string                 `protobuf:"bytes,2,opt,name=last_evaluated_key,json=lastEvaluatedKey,proto3" json:"last_evaluated_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListMyURLsResponse) Reset() {
+	*x = ListMyURLsResponse{}
+	mi := &file_main_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyURLsResponse) ProtoMessage() {}
+
+func (x *ListMyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMyURLsResponse) GetUrls() []*UrlItem {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ListMyURLsResponse) GetLastEvaluatedKey() string {
+	if x != nil {
+		return x.LastEvaluatedKey
+	}
+	return ""
+}
+
 // Shared structure for URL details
 type UrlItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortId       string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	ShortId       string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"` // "<domain>/<id>" for links on a custom domain
 	OriginalUrl   string                 `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ExpireAt      int64                  `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 	Clicks        int64                  `protobuf:"varint,5,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Flagged       bool                   `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	WorkspaceId   string                 `protobuf:"bytes,8,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,9,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UrlItem) Reset() {
 	*x = UrlItem{}
-	mi := &file_main_proto_msgTypes[16]
+	mi := &file_main_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1088,7 @@ func (x *UrlItem) String() string {
 func (*UrlItem) ProtoMessage() {}
 
 func (x *UrlItem) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[16]
+	mi := &file_main_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1101,7 @@ func (x *UrlItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlItem.ProtoReflect.Descriptor instead.
 func (*UrlItem) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{16}
+	return file_main_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UrlItem) GetShortId() string {
@@ -911,76 +1139,1244 @@ func (x *UrlItem) GetClicks() int64 {
 	return 0
 }
 
-var File_main_proto protoreflect.FileDescriptor
+func (x *UrlItem) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
 
-const file_main_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"main.proto\x12\x04main\"b\n" +
-	"\x11ShortenURLRequest\x12!\n" +
-	"\foriginal_url\x18\x01 \x01(\tR\voriginalUrl\x12*\n" +
-	"\x11expire_in_seconds\x18\x02 \x01(\x03R\x0fexpireInSeconds\"\x88\x01\n" +
-	"\x12ShortenURLResponse\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12\x1b\n" +
-	"\tshort_url\x18\x02 \x01(\tR\bshortUrl\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1b\n" +
-	"\texpire_at\x18\x04 \x01(\x03R\bexpireAt\"2\n" +
-	"\x15GetOriginalURLRequest\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\";\n" +
-	"\x16GetOriginalURLResponse\x12!\n" +
-	"\foriginal_url\x18\x01 \x01(\tR\voriginalUrl\"2\n" +
-	"\x15IncrementClickRequest\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\"0\n" +
-	"\x16IncrementClickResponse\x12\x16\n" +
-	"\x06clicks\x18\x01 \x01(\x03R\x06clicks\"\x14\n" +
-	"\x12HealthCheckRequest\"-\n" +
-	"\x13HealthCheckResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"/\n" +
-	"\x12GetURLStatsRequest\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\"\xa7\x01\n" +
-	"\x13GetURLStatsResponse\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12!\n" +
-	"\foriginal_url\x18\x02 \x01(\tR\voriginalUrl\x12\x16\n" +
-	"\x06clicks\x18\x03 \x01(\x03R\x06clicks\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
-	"\texpire_at\x18\x05 \x01(\x03R\bexpireAt\"\x8a\x01\n" +
-	"\x10UpdateURLRequest\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12(\n" +
-	"\x10new_original_url\x18\x02 \x01(\tR\x0enewOriginalUrl\x121\n" +
-	"\x15new_expire_in_seconds\x18\x03 \x01(\x03R\x12newExpireInSeconds\"G\n" +
-	"\x11UpdateURLResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
-	"\x10DeleteURLRequest\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\"G\n" +
-	"\x11DeleteURLResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"X\n" +
-	"\x12ListAllURLsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12,\n" +
-	"\x12last_evaluated_key\x18\x02 \x01(\tR\x10lastEvaluatedKey\"f\n" +
-	"\x13ListAllURLsResponse\x12!\n" +
-	"\x04urls\x18\x01 \x03(\v2\r.main.UrlItemR\x04urls\x12,\n" +
-	"\x12last_evaluated_key\x18\x02 \x01(\tR\x10lastEvaluatedKey\"\x9b\x01\n" +
-	"\aUrlItem\x12\x19\n" +
-	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12!\n" +
-	"\foriginal_url\x18\x02 \x01(\tR\voriginalUrl\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1b\n" +
-	"\texpire_at\x18\x04 \x01(\x03R\bexpireAt\x12\x16\n" +
-	"\x06clicks\x18\x05 \x01(\x03R\x06clicks2\xb1\x04\n" +
-	"\fUrlShortener\x12?\n" +
-	"\n" +
-	"ShortenURL\x12\x17.main.ShortenURLRequest\x1a\x18.main.ShortenURLResponse\x12K\n" +
-	"\x0eGetOriginalURL\x12\x1b.main.GetOriginalURLRequest\x1a\x1c.main.GetOriginalURLResponse\x12K\n" +
-	"\x0eIncrementClick\x12\x1b.main.IncrementClickRequest\x1a\x1c.main.IncrementClickResponse\x12B\n" +
-	"\vHealthCheck\x12\x18.main.HealthCheckRequest\x1a\x19.main.HealthCheckResponse\x12B\n" +
-	"\vGetURLStats\x12\x18.main.GetURLStatsRequest\x1a\x19.main.GetURLStatsResponse\x12<\n" +
-	"\tUpdateURL\x12\x16.main.UpdateURLRequest\x1a\x17.main.UpdateURLResponse\x12<\n" +
-	"\tDeleteURL\x12\x16.main.DeleteURLRequest\x1a\x17.main.DeleteURLResponse\x12B\n" +
-	"\vListAllURLs\x12\x18.main.ListAllURLsRequest\x1a\x19.main.ListAllURLsResponseB\x12Z\x10proto/gen;mainpbb\x06proto3"
+func (x *UrlItem) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UrlItem) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *UrlItem) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// CreateAPIKey
+type CreateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Label to recognise the key by
+	// Any of "read", "write", "admin"; admin implies write, write implies read
+	Scopes        []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_main_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Only returned once; send it as "authorization: Bearer <api_key>"
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_main_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAPIKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// RevokeAPIKey
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_main_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_main_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeAPIKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CreateWorkspace
+type CreateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
+	mi := &file_main_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
+	mi := &file_main_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWorkspaceResponse) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// InviteMember
+type InviteMemberRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// Member subject: "key:<api key id>" or "jwt:<issuer>|<sub>"
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	mi := &file_main_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InviteMemberRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteMemberResponse) Reset() {
+	*x = InviteMemberResponse{}
+	mi := &file_main_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberResponse) ProtoMessage() {}
+
+func (x *InviteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InviteMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InviteMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RemoveMember
+type RemoveMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_main_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveMemberRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_main_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TransferURL
+type TransferURLRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShortId string                 `protobuf:"bytes,1,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	// Target workspace (editor role); empty moves the link back to its owner's personal links
+	WorkspaceId   string `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferURLRequest) Reset() {
+	*x = TransferURLRequest{}
+	mi := &file_main_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferURLRequest) ProtoMessage() {}
+
+func (x *TransferURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferURLRequest.ProtoReflect.Descriptor instead.
+func (*TransferURLRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransferURLRequest) GetShortId() string {
+	if x != nil {
+		return x.ShortId
+	}
+	return ""
+}
+
+func (x *TransferURLRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type TransferURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferURLResponse) Reset() {
+	*x = TransferURLResponse{}
+	mi := &file_main_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferURLResponse) ProtoMessage() {}
+
+func (x *TransferURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferURLResponse.ProtoReflect.Descriptor instead.
+func (*TransferURLResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransferURLResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferURLResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetQuota
+type GetQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional owner subject to inspect (admin scope), the caller if empty
+	OwnerId       string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	mi := &file_main_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetQuotaRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type GetQuotaResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Limits are 0 and remaining counts -1 when a quota is unlimited
+	MaxActiveLinks       int64 `protobuf:"varint,2,opt,name=max_active_links,json=maxActiveLinks,proto3" json:"max_active_links,omitempty"`
+	ActiveLinks          int64 `protobuf:"varint,3,opt,name=active_links,json=activeLinks,proto3" json:"active_links,omitempty"`
+	RemainingActiveLinks int64 `protobuf:"varint,4,opt,name=remaining_active_links,json=remainingActiveLinks,proto3" json:"remaining_active_links,omitempty"`
+	MaxDailyLinks        int64 `protobuf:"varint,5,opt,name=max_daily_links,json=maxDailyLinks,proto3" json:"max_daily_links,omitempty"`
+	DailyLinks           int64 `protobuf:"varint,6,opt,name=daily_links,json=dailyLinks,proto3" json:"daily_links,omitempty"`
+	RemainingDailyLinks  int64 `protobuf:"varint,7,opt,name=remaining_daily_links,json=remainingDailyLinks,proto3" json:"remaining_daily_links,omitempty"`
+	DailyResetAt         int64 `protobuf:"varint,8,opt,name=daily_reset_at,json=dailyResetAt,proto3" json:"daily_reset_at,omitempty"` // unix seconds, next midnight UTC
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	mi := &file_main_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetQuotaResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetQuotaResponse) GetMaxActiveLinks() int64 {
+	if x != nil {
+		return x.MaxActiveLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetActiveLinks() int64 {
+	if x != nil {
+		return x.ActiveLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetRemainingActiveLinks() int64 {
+	if x != nil {
+		return x.RemainingActiveLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetMaxDailyLinks() int64 {
+	if x != nil {
+		return x.MaxDailyLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetDailyLinks() int64 {
+	if x != nil {
+		return x.DailyLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetRemainingDailyLinks() int64 {
+	if x != nil {
+		return x.RemainingDailyLinks
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetDailyResetAt() int64 {
+	if x != nil {
+		return x.DailyResetAt
+	}
+	return 0
+}
+
+// RegisterDomain
+type RegisterDomainRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Domain string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Optional workspace whose editors may create links on the domain
+	WorkspaceId   string `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDomainRequest) Reset() {
+	*x = RegisterDomainRequest{}
+	mi := &file_main_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDomainRequest) ProtoMessage() {}
+
+func (x *RegisterDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDomainRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDomainRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RegisterDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RegisterDomainRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type RegisterDomainResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Domain string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Publish a TXT record with this name and value, then call VerifyDomain
+	VerificationRecordName  string `protobuf:"bytes,2,opt,name=verification_record_name,json=verificationRecordName,proto3" json:"verification_record_name,omitempty"`
+	VerificationRecordValue string `protobuf:"bytes,3,opt,name=verification_record_value,json=verificationRecordValue,proto3" json:"verification_record_value,omitempty"`
+	Verified                bool   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	WorkspaceId             string `protobuf:"bytes,5,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RegisterDomainResponse) Reset() {
+	*x = RegisterDomainResponse{}
+	mi := &file_main_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDomainResponse) ProtoMessage() {}
+
+func (x *RegisterDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDomainResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDomainResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegisterDomainResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RegisterDomainResponse) GetVerificationRecordName() string {
+	if x != nil {
+		return x.VerificationRecordName
+	}
+	return ""
+}
+
+func (x *RegisterDomainResponse) GetVerificationRecordValue() string {
+	if x != nil {
+		return x.VerificationRecordValue
+	}
+	return ""
+}
+
+func (x *RegisterDomainResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *RegisterDomainResponse) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+// VerifyDomain
+type VerifyDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_main_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type VerifyDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerifiedAt    int64                  `protobuf:"varint,3,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_main_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifyDomainResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *VerifyDomainResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *VerifyDomainResponse) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+var File_main_proto protoreflect.FileDescriptor
+
+const file_main_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"main.proto\x12\x04main\x1a\x17validate/validate.proto\"\xdb\x02\n" +
+	"\x11ShortenURLRequest\x12.\n" +
+	"\foriginal_url\x18\x01 \x01(\tB\v\xfaB\br\x06\x18\x80\x10\x88\x01\x01R\voriginalUrl\x123\n" +
+	"\x11expire_in_seconds\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpireInSeconds\x12Z\n" +
+	"\fcustom_alias\x18\x03 \x01(\tB7\xfaB4r2\x10\x03\x18@2)^[A-Za-z0-9]([A-Za-z0-9_-]*[A-Za-z0-9])?$\xd0\x01\x01R\vcustomAlias\x12$\n" +
+	"\texpire_at\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bexpireAt\x12:\n" +
+	"\fworkspace_id\x18\x05 \x01(\tB\x17\xfaB\x14r\x12\x18@2\v^[A-Z2-7]+$\xd0\x01\x01R\vworkspaceId\x12#\n" +
+	"\x06domain\x18\x06 \x01(\tB\v\xfaB\br\x06\x18\xfd\x01\xd0\x01\x01R\x06domain\"\xdd\x01\n" +
+	"\x12ShortenURLResponse\x12\x19\n" +
+	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12\x1b\n" +
+	"\tshort_url\x18\x02 \x01(\tR\bshortUrl\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\texpire_at\x18\x04 \x01(\x03R\bexpireAt\x12\x18\n" +
+	"\aflagged\x18\x05 \x01(\bR\aflagged\x12!\n" +
+	"\fworkspace_id\x18\x06 \x01(\tR\vworkspaceId\x12\x16\n" +
+	"\x06domain\x18\a \x01(\tR\x06domain\"_\n" +
+	"\x15GetOriginalURLRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\";\n" +
+	"\x16GetOriginalURLResponse\x12!\n" +
+	"\foriginal_url\x18\x01 \x01(\tR\voriginalUrl\"_\n" +
+	"\x15IncrementClickRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\"0\n" +
+	"\x16IncrementClickResponse\x12\x16\n" +
+	"\x06clicks\x18\x01 \x01(\x03R\x06clicks\"\x14\n" +
+	"\x12HealthCheckRequest\"-\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\\\n" +
+	"\x12GetURLStatsRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\"\x97\x02\n" +
+	"\x13GetURLStatsResponse\x12\x19\n" +
+	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12!\n" +
+	"\foriginal_url\x18\x02 \x01(\tR\voriginalUrl\x12\x16\n" +
+	"\x06clicks\x18\x03 \x01(\x03R\x06clicks\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\texpire_at\x18\x05 \x01(\x03R\bexpireAt\x12\x18\n" +
+	"\aflagged\x18\x06 \x01(\bR\aflagged\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\x12!\n" +
+	"\fworkspace_id\x18\b \x01(\tR\vworkspaceId\x12\x16\n" +
+	"\x06domain\x18\t \x01(\tR\x06domain\"\xa0\x02\n" +
+	"\x10UpdateURLRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\x128\n" +
+	"\x10new_original_url\x18\x02 \x01(\tB\x0e\xfaB\vr\t\x18\x80\x10\xd0\x01\x01\x88\x01\x01R\x0enewOriginalUrl\x12:\n" +
+	"\x15new_expire_in_seconds\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x12newExpireInSeconds\x12+\n" +
+	"\rnew_expire_at\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vnewExpireAt\x12!\n" +
+	"\fclear_expiry\x18\x05 \x01(\bR\vclearExpiry\"G\n" +
+	"\x11UpdateURLResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\x10DeleteURLRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\"G\n" +
+	"\x11DeleteURLResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"n\n" +
+	"\x12ListAllURLsRequest\x12 \n" +
+	"\x05limit\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\x126\n" +
+	"\x12last_evaluated_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc0\x02R\x10lastEvaluatedKey\"f\n" +
+	"\x13ListAllURLsResponse\x12!\n" +
+	"\x04urls\x18\x01 \x03(\v2\r.main.UrlItemR\x04urls\x12,\n" +
+	"\x12last_evaluated_key\x18\x02 \x01(\tR\x10lastEvaluatedKey\"\xa9\x01\n" +
+	"\x11ListMyURLsRequest\x12 \n" +
+	"\x05limit\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\x126\n" +
+	"\x12last_evaluated_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc0\x02R\x10lastEvaluatedKey\x12:\n" +
+	"\fworkspace_id\x18\x03 \x01(\tB\x17\xfaB\x14r\x12\x18@2\v^[A-Z2-7]+$\xd0\x01\x01R\vworkspaceId\"e\n" +
+	"\x12ListMyURLsResponse\x12!\n" +
+	"\x04urls\x18\x01 \x03(\v2\r.main.UrlItemR\x04urls\x12,\n" +
+	"\x12last_evaluated_key\x18\x02 \x01(\tR\x10lastEvaluatedKey\"\x8b\x02\n" +
+	"\aUrlItem\x12\x19\n" +
+	"\bshort_id\x18\x01 \x01(\tR\ashortId\x12!\n" +
+	"\foriginal_url\x18\x02 \x01(\tR\voriginalUrl\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\texpire_at\x18\x04 \x01(\x03R\bexpireAt\x12\x16\n" +
+	"\x06clicks\x18\x05 \x01(\x03R\x06clicks\x12\x18\n" +
+	"\aflagged\x18\x06 \x01(\bR\aflagged\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\x12!\n" +
+	"\fworkspace_id\x18\b \x01(\tR\vworkspaceId\x12\x16\n" +
+	"\x06domain\x18\t \x01(\tR\x06domain\"o\n" +
+	"\x13CreateAPIKeyRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x04name\x12:\n" +
+	"\x06scopes\x18\x02 \x03(\tB\"\xfaB\x1f\x92\x01\x1c\b\x01\x18\x01\"\x16r\x14R\x04readR\x05writeR\x05adminR\x06scopes\"}\n" +
+	"\x14CreateAPIKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"D\n" +
+	"\x13RevokeAPIKeyRequest\x12-\n" +
+	"\x06key_id\x18\x01 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18@2\v^[A-Z2-7]+$R\x05keyId\"J\n" +
+	"\x14RevokeAPIKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"8\n" +
+	"\x16CreateWorkspaceRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\"o\n" +
+	"\x17CreateWorkspaceResponse\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\xb4\x01\n" +
+	"\x13InviteMemberRequest\x129\n" +
+	"\fworkspace_id\x18\x01 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18@2\v^[A-Z2-7]+$R\vworkspaceId\x120\n" +
+	"\auser_id\x18\x02 \x01(\tB\x17\xfaB\x14r\x12\x10\x05\x18\x80\x022\v^(key|jwt):R\x06userId\x120\n" +
+	"\x04role\x18\x03 \x01(\tB\x1c\xfaB\x19r\x17R\x06viewerR\x06editorR\x05adminR\x04role\"J\n" +
+	"\x14InviteMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x01\n" +
+	"\x13RemoveMemberRequest\x129\n" +
+	"\fworkspace_id\x18\x01 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18@2\v^[A-Z2-7]+$R\vworkspaceId\x120\n" +
+	"\auser_id\x18\x02 \x01(\tB\x17\xfaB\x14r\x12\x10\x05\x18\x80\x022\v^(key|jwt):R\x06userId\"J\n" +
+	"\x14RemoveMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x98\x01\n" +
+	"\x12TransferURLRequest\x12F\n" +
+	"\bshort_id\x18\x01 \x01(\tB+\xfaB(r&\x10\x01\x18\xc0\x022\x1f^([a-z0-9.-]+/)?[A-Za-z0-9_-]+$R\ashortId\x12:\n" +
+	"\fworkspace_id\x18\x02 \x01(\tB\x17\xfaB\x14r\x12\x18@2\v^[A-Z2-7]+$\xd0\x01\x01R\vworkspaceId\"I\n" +
+	"\x13TransferURLResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"F\n" +
+	"\x0fGetQuotaRequest\x123\n" +
+	"\bowner_id\x18\x01 \x01(\tB\x18\xfaB\x15r\x13\x18\x80\x022\v^(key|jwt):\xd0\x01\x01R\aownerId\"\xd3\x02\n" +
+	"\x10GetQuotaResponse\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12(\n" +
+	"\x10max_active_links\x18\x02 \x01(\x03R\x0emaxActiveLinks\x12!\n" +
+	"\factive_links\x18\x03 \x01(\x03R\vactiveLinks\x124\n" +
+	"\x16remaining_active_links\x18\x04 \x01(\x03R\x14remainingActiveLinks\x12&\n" +
+	"\x0fmax_daily_links\x18\x05 \x01(\x03R\rmaxDailyLinks\x12\x1f\n" +
+	"\vdaily_links\x18\x06 \x01(\x03R\n" +
+	"dailyLinks\x122\n" +
+	"\x15remaining_daily_links\x18\a \x01(\x03R\x13remainingDailyLinks\x12$\n" +
+	"\x0edaily_reset_at\x18\b \x01(\x03R\fdailyResetAt\"w\n" +
+	"\x15RegisterDomainRequest\x12\"\n" +
+	"\x06domain\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xfd\x01R\x06domain\x12:\n" +
+	"\fworkspace_id\x18\x02 \x01(\tB\x17\xfaB\x14r\x12\x18@2\v^[A-Z2-7]+$\xd0\x01\x01R\vworkspaceId\"\xe5\x01\n" +
+	"\x16RegisterDomainResponse\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x128\n" +
+	"\x18verification_record_name\x18\x02 \x01(\tR\x16verificationRecordName\x12:\n" +
+	"\x19verification_record_value\x18\x03 \x01(\tR\x17verificationRecordValue\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\x12!\n" +
+	"\fworkspace_id\x18\x05 \x01(\tR\vworkspaceId\"9\n" +
+	"\x13VerifyDomainRequest\x12\"\n" +
+	"\x06domain\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xfd\x01R\x06domain\"k\n" +
+	"\x14VerifyDomainResponse\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12\x1f\n" +
+	"\vverified_at\x18\x03 \x01(\x03R\n" +
+	"verifiedAt2\xf1\t\n" +
+	"\fUrlShortener\x12?\n" +
+	"\n" +
+	"ShortenURL\x12\x17.main.ShortenURLRequest\x1a\x18.main.ShortenURLResponse\x12K\n" +
+	"\x0eGetOriginalURL\x12\x1b.main.GetOriginalURLRequest\x1a\x1c.main.GetOriginalURLResponse\x12K\n" +
+	"\x0eIncrementClick\x12\x1b.main.IncrementClickRequest\x1a\x1c.main.IncrementClickResponse\x12B\n" +
+	"\vHealthCheck\x12\x18.main.HealthCheckRequest\x1a\x19.main.HealthCheckResponse\x12B\n" +
+	"\vGetURLStats\x12\x18.main.GetURLStatsRequest\x1a\x19.main.GetURLStatsResponse\x12<\n" +
+	"\tUpdateURL\x12\x16.main.UpdateURLRequest\x1a\x17.main.UpdateURLResponse\x12<\n" +
+	"\tDeleteURL\x12\x16.main.DeleteURLRequest\x1a\x17.main.DeleteURLResponse\x12B\n" +
+	"\vListAllURLs\x12\x18.main.ListAllURLsRequest\x1a\x19.main.ListAllURLsResponse\x12?\n" +
+	"\n" +
+	"ListMyURLs\x12\x17.main.ListMyURLsRequest\x1a\x18.main.ListMyURLsResponse\x12E\n" +
+	"\fCreateAPIKey\x12\x19.main.CreateAPIKeyRequest\x1a\x1a.main.CreateAPIKeyResponse\x12E\n" +
+	"\fRevokeAPIKey\x12\x19.main.RevokeAPIKeyRequest\x1a\x1a.main.RevokeAPIKeyResponse\x12N\n" +
+	"\x0fCreateWorkspace\x12\x1c.main.CreateWorkspaceRequest\x1a\x1d.main.CreateWorkspaceResponse\x12E\n" +
+	"\fInviteMember\x12\x19.main.InviteMemberRequest\x1a\x1a.main.InviteMemberResponse\x12E\n" +
+	"\fRemoveMember\x12\x19.main.RemoveMemberRequest\x1a\x1a.main.RemoveMemberResponse\x12B\n" +
+	"\vTransferURL\x12\x18.main.TransferURLRequest\x1a\x19.main.TransferURLResponse\x129\n" +
+	"\bGetQuota\x12\x15.main.GetQuotaRequest\x1a\x16.main.GetQuotaResponse\x12K\n" +
+	"\x0eRegisterDomain\x12\x1b.main.RegisterDomainRequest\x1a\x1c.main.RegisterDomainResponse\x12E\n" +
+	"\fVerifyDomain\x12\x19.main.VerifyDomainRequest\x1a\x1a.main.VerifyDomainResponseB\x12Z\x10proto/gen;mainpbb\x06proto3"
 
 var (
 	file_main_proto_rawDescOnce sync.Once
@@ -994,49 +2390,90 @@ func file_main_proto_rawDescGZIP() []byte {
 	return file_main_proto_rawDescData
 }
 
-var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_main_proto_goTypes = []any{
-	(*ShortenURLRequest)(nil),      // 0: main.ShortenURLRequest
-	(*ShortenURLResponse)(nil),     // 1: main.ShortenURLResponse
-	(*GetOriginalURLRequest)(nil),  // 2: main.GetOriginalURLRequest
-	(*GetOriginalURLResponse)(nil), // 3: main.GetOriginalURLResponse
-	(*IncrementClickRequest)(nil),  // 4: main.IncrementClickRequest
-	(*IncrementClickResponse)(nil), // 5: main.IncrementClickResponse
-	(*HealthCheckRequest)(nil),     // 6: main.HealthCheckRequest
-	(*HealthCheckResponse)(nil),    // 7: main.HealthCheckResponse
-	(*GetURLStatsRequest)(nil),     // 8: main.GetURLStatsRequest
-	(*GetURLStatsResponse)(nil),    // 9: main.GetURLStatsResponse
-	(*UpdateURLRequest)(nil),       // 10: main.UpdateURLRequest
-	(*UpdateURLResponse)(nil),      // 11: main.UpdateURLResponse
-	(*DeleteURLRequest)(nil),       // 12: main.DeleteURLRequest
-	(*DeleteURLResponse)(nil),      // 13: main.DeleteURLResponse
-	(*ListAllURLsRequest)(nil),     // 14: main.ListAllURLsRequest
-	(*ListAllURLsResponse)(nil),    // 15: main.ListAllURLsResponse
-	(*UrlItem)(nil),                // 16: main.UrlItem
+	(*ShortenURLRequest)(nil),       // 0: main.ShortenURLRequest
+	(*ShortenURLResponse)(nil),      // 1: main.ShortenURLResponse
+	(*GetOriginalURLRequest)(nil),   // 2: main.GetOriginalURLRequest
+	(*GetOriginalURLResponse)(nil),  // 3: main.GetOriginalURLResponse
+	(*IncrementClickRequest)(nil),   // 4: main.IncrementClickRequest
+	(*IncrementClickResponse)(nil),  // 5: main.IncrementClickResponse
+	(*HealthCheckRequest)(nil),      // 6: main.HealthCheckRequest
+	(*HealthCheckResponse)(nil),     // 7: main.HealthCheckResponse
+	(*GetURLStatsRequest)(nil),      // 8: main.GetURLStatsRequest
+	(*GetURLStatsResponse)(nil),     // 9: main.GetURLStatsResponse
+	(*UpdateURLRequest)(nil),        // 10: main.UpdateURLRequest
+	(*UpdateURLResponse)(nil),       // 11: main.UpdateURLResponse
+	(*DeleteURLRequest)(nil),        // 12: main.DeleteURLRequest
+	(*DeleteURLResponse)(nil),       // 13: main.DeleteURLResponse
+	(*ListAllURLsRequest)(nil),      // 14: main.ListAllURLsRequest
+	(*ListAllURLsResponse)(nil),     // 15: main.ListAllURLsResponse
+	(*ListMyURLsRequest)(nil),       // 16: main.ListMyURLsRequest
+	(*ListMyURLsResponse)(nil),      // 17: main.ListMyURLsResponse
+	(*UrlItem)(nil),                 // 18: main.UrlItem
+	(*CreateAPIKeyRequest)(nil),     // 19: main.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),    // 20: main.CreateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),     // 21: main.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),    // 22: main.RevokeAPIKeyResponse
+	(*CreateWorkspaceRequest)(nil),  // 23: main.CreateWorkspaceRequest
+	(*CreateWorkspaceResponse)(nil), // 24: main.CreateWorkspaceResponse
+	(*InviteMemberRequest)(nil),     // 25: main.InviteMemberRequest
+	(*InviteMemberResponse)(nil),    // 26: main.InviteMemberResponse
+	(*RemoveMemberRequest)(nil),     // 27: main.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),    // 28: main.RemoveMemberResponse
+	(*TransferURLRequest)(nil),      // 29: main.TransferURLRequest
+	(*TransferURLResponse)(nil),     // 30: main.TransferURLResponse
+	(*GetQuotaRequest)(nil),         // 31: main.GetQuotaRequest
+	(*GetQuotaResponse)(nil),        // 32: main.GetQuotaResponse
+	(*RegisterDomainRequest)(nil),   // 33: main.RegisterDomainRequest
+	(*RegisterDomainResponse)(nil),  // 34: main.RegisterDomainResponse
+	(*VerifyDomainRequest)(nil),     // 35: main.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),    // 36: main.VerifyDomainResponse
 }
 var file_main_proto_depIdxs = []int32{
-	16, // 0: main.ListAllURLsResponse.urls:type_name -> main.UrlItem
-	0,  // 1: main.UrlShortener.ShortenURL:input_type -> main.ShortenURLRequest
-	2,  // 2: main.UrlShortener.GetOriginalURL:input_type -> main.GetOriginalURLRequest
-	4,  // 3: main.UrlShortener.IncrementClick:input_type -> main.IncrementClickRequest
-	6,  // 4: main.UrlShortener.HealthCheck:input_type -> main.HealthCheckRequest
-	8,  // 5: main.UrlShortener.GetURLStats:input_type -> main.GetURLStatsRequest
-	10, // 6: main.UrlShortener.UpdateURL:input_type -> main.UpdateURLRequest
-	12, // 7: main.UrlShortener.DeleteURL:input_type -> main.DeleteURLRequest
-	14, // 8: main.UrlShortener.ListAllURLs:input_type -> main.ListAllURLsRequest
-	1,  // 9: main.UrlShortener.ShortenURL:output_type -> main.ShortenURLResponse
-	3,  // 10: main.UrlShortener.GetOriginalURL:output_type -> main.GetOriginalURLResponse
-	5,  // 11: main.UrlShortener.IncrementClick:output_type -> main.IncrementClickResponse
-	7,  // 12: main.UrlShortener.HealthCheck:output_type -> main.HealthCheckResponse
-	9,  // 13: main.UrlShortener.GetURLStats:output_type -> main.GetURLStatsResponse
-	11, // 14: main.UrlShortener.UpdateURL:output_type -> main.UpdateURLResponse
-	13, // 15: main.UrlShortener.DeleteURL:output_type -> main.DeleteURLResponse
-	15, // 16: main.UrlShortener.ListAllURLs:output_type -> main.ListAllURLsResponse
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	18, // 0: main.ListAllURLsResponse.urls:type_name -> main.UrlItem
+	18, // 1: main.ListMyURLsResponse.urls:type_name -> main.UrlItem
+	0,  // 2: main.UrlShortener.ShortenURL:input_type -> main.ShortenURLRequest
+	2,  // 3: main.UrlShortener.GetOriginalURL:input_type -> main.GetOriginalURLRequest
+	4,  // 4: main.UrlShortener.IncrementClick:input_type -> main.IncrementClickRequest
+	6,  // 5: main.UrlShortener.HealthCheck:input_type -> main.HealthCheckRequest
+	8,  // 6: main.UrlShortener.GetURLStats:input_type -> main.GetURLStatsRequest
+	10, // 7: main.UrlShortener.UpdateURL:input_type -> main.UpdateURLRequest
+	12, // 8: main.UrlShortener.DeleteURL:input_type -> main.DeleteURLRequest
+	14, // 9: main.UrlShortener.ListAllURLs:input_type -> main.ListAllURLsRequest
+	16, // 10: main.UrlShortener.ListMyURLs:input_type -> main.ListMyURLsRequest
+	19, // 11: main.UrlShortener.CreateAPIKey:input_type -> main.CreateAPIKeyRequest
+	21, // 12: main.UrlShortener.RevokeAPIKey:input_type -> main.RevokeAPIKeyRequest
+	23, // 13: main.UrlShortener.CreateWorkspace:input_type -> main.CreateWorkspaceRequest
+	25, // 14: main.UrlShortener.InviteMember:input_type -> main.InviteMemberRequest
+	27, // 15: main.UrlShortener.RemoveMember:input_type -> main.RemoveMemberRequest
+	29, // 16: main.UrlShortener.TransferURL:input_type -> main.TransferURLRequest
+	31, // 17: main.UrlShortener.GetQuota:input_type -> main.GetQuotaRequest
+	33, // 18: main.UrlShortener.RegisterDomain:input_type -> main.RegisterDomainRequest
+	35, // 19: main.UrlShortener.VerifyDomain:input_type -> main.VerifyDomainRequest
+	1,  // 20: main.UrlShortener.ShortenURL:output_type -> main.ShortenURLResponse
+	3,  // 21: main.UrlShortener.GetOriginalURL:output_type -> main.GetOriginalURLResponse
+	5,  // 22: main.UrlShortener.IncrementClick:output_type -> main.IncrementClickResponse
+	7,  // 23: main.UrlShortener.HealthCheck:output_type -> main.HealthCheckResponse
+	9,  // 24: main.UrlShortener.GetURLStats:output_type -> main.GetURLStatsResponse
+	11, // 25: main.UrlShortener.UpdateURL:output_type -> main.UpdateURLResponse
+	13, // 26: main.UrlShortener.DeleteURL:output_type -> main.DeleteURLResponse
+	15, // 27: main.UrlShortener.ListAllURLs:output_type -> main.ListAllURLsResponse
+	17, // 28: main.UrlShortener.ListMyURLs:output_type -> main.ListMyURLsResponse
+	20, // 29: main.UrlShortener.CreateAPIKey:output_type -> main.CreateAPIKeyResponse
+	22, // 30: main.UrlShortener.RevokeAPIKey:output_type -> main.RevokeAPIKeyResponse
+	24, // 31: main.UrlShortener.CreateWorkspace:output_type -> main.CreateWorkspaceResponse
+	26, // 32: main.UrlShortener.InviteMember:output_type -> main.InviteMemberResponse
+	28, // 33: main.UrlShortener.RemoveMember:output_type -> main.RemoveMemberResponse
+	30, // 34: main.UrlShortener.TransferURL:output_type -> main.TransferURLResponse
+	32, // 35: main.UrlShortener.GetQuota:output_type -> main.GetQuotaResponse
+	34, // 36: main.UrlShortener.RegisterDomain:output_type -> main.RegisterDomainResponse
+	36, // 37: main.UrlShortener.VerifyDomain:output_type -> main.VerifyDomainResponse
+	20, // [20:38] is the sub-list for method output_type
+	2,  // [2:20] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_main_proto_init() }
@@ -1050,7 +2487,7 @@ func file_main_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_main_proto_rawDesc), len(file_main_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UrlShortener_ShortenURL_FullMethodName     = "/main.UrlShortener/ShortenURL"
-	UrlShortener_GetOriginalURL_FullMethodName = "/main.UrlShortener/GetOriginalURL"
-	UrlShortener_IncrementClick_FullMethodName = "/main.UrlShortener/IncrementClick"
-	UrlShortener_HealthCheck_FullMethodName    = "/main.UrlShortener/HealthCheck"
-	UrlShortener_GetURLStats_FullMethodName    = "/main.UrlShortener/GetURLStats"
-	UrlShortener_UpdateURL_FullMethodName      = "/main.UrlShortener/UpdateURL"
-	UrlShortener_DeleteURL_FullMethodName      = "/main.UrlShortener/DeleteURL"
-	UrlShortener_ListAllURLs_FullMethodName    = "/main.UrlShortener/ListAllURLs"
+	UrlShortener_ShortenURL_FullMethodName      = "/main.UrlShortener/ShortenURL"
+	UrlShortener_GetOriginalURL_FullMethodName  = "/main.UrlShortener/GetOriginalURL"
+	UrlShortener_IncrementClick_FullMethodName  = "/main.UrlShortener/IncrementClick"
+	UrlShortener_HealthCheck_FullMethodName     = "/main.UrlShortener/HealthCheck"
+	UrlShortener_GetURLStats_FullMethodName     = "/main.UrlShortener/GetURLStats"
+	UrlShortener_UpdateURL_FullMethodName       = "/main.UrlShortener/UpdateURL"
+	UrlShortener_DeleteURL_FullMethodName       = "/main.UrlShortener/DeleteURL"
+	UrlShortener_ListAllURLs_FullMethodName     = "/main.UrlShortener/ListAllURLs"
+	UrlShortener_ListMyURLs_FullMethodName      = "/main.UrlShortener/ListMyURLs"
+	UrlShortener_CreateAPIKey_FullMethodName    = "/main.UrlShortener/CreateAPIKey"
+	UrlShortener_RevokeAPIKey_FullMethodName    = "/main.UrlShortener/RevokeAPIKey"
+	UrlShortener_CreateWorkspace_FullMethodName = "/main.UrlShortener/CreateWorkspace"
+	UrlShortener_InviteMember_FullMethodName    = "/main.UrlShortener/InviteMember"
+	UrlShortener_RemoveMember_FullMethodName    = "/main.UrlShortener/RemoveMember"
+	UrlShortener_TransferURL_FullMethodName     = "/main.UrlShortener/TransferURL"
+	UrlShortener_GetQuota_FullMethodName        = "/main.UrlShortener/GetQuota"
+	UrlShortener_RegisterDomain_FullMethodName  = "/main.UrlShortener/RegisterDomain"
+	UrlShortener_VerifyDomain_FullMethodName    = "/main.UrlShortener/VerifyDomain"
 )
 
 // UrlShortenerClient is the client API for UrlShortener service.
@@ -39,16 +49,36 @@ type UrlShortenerClient interface {
 	GetOriginalURL(ctx context.Context, in *GetOriginalURLRequest, opts ...grpc.CallOption) (*GetOriginalURLResponse, error)
 	// Increment click counter whenever a short link is used
 	IncrementClick(ctx context.Context, in *IncrementClickRequest, opts ...grpc.CallOption) (*IncrementClickResponse, error)
-	// ✅ New RPC: Health check endpoint
+	// Health check endpoint
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	// ✅ New RPC: Get analytics and metadata for a specific URL
+	// Get analytics and metadata for a specific URL
 	GetURLStats(ctx context.Context, in *GetURLStatsRequest, opts ...grpc.CallOption) (*GetURLStatsResponse, error)
-	// ✅ New RPC: Update an existing short URL (change destination or expiry)
+	// Update an existing short URL (change destination or expiry)
 	UpdateURL(ctx context.Context, in *UpdateURLRequest, opts ...grpc.CallOption) (*UpdateURLResponse, error)
-	// ✅ New RPC: Delete a short URL by ID
+	// Delete a short URL by ID
 	DeleteURL(ctx context.Context, in *DeleteURLRequest, opts ...grpc.CallOption) (*DeleteURLResponse, error)
-	// ✅ New RPC: List all shortened URLs (with optional pagination)
+	// List all shortened URLs (with optional pagination, admin scope)
 	ListAllURLs(ctx context.Context, in *ListAllURLsRequest, opts ...grpc.CallOption) (*ListAllURLsResponse, error)
+	// List the caller's own shortened URLs (with optional pagination)
+	ListMyURLs(ctx context.Context, in *ListMyURLsRequest, opts ...grpc.CallOption) (*ListMyURLsResponse, error)
+	// Create an API key for this API (admin scope)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// Revoke an API key by ID (admin scope)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// Create a workspace; the caller becomes its admin
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error)
+	// Add a member to a workspace or change their role (workspace admin)
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*InviteMemberResponse, error)
+	// Remove a member from a workspace (workspace admin)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// Move a short URL to another workspace, or back to personal links
+	TransferURL(ctx context.Context, in *TransferURLRequest, opts ...grpc.CallOption) (*TransferURLResponse, error)
+	// Get the caller's link quotas and current usage
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error)
+	// Register a custom domain for short links
+	RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error)
+	// Check a registered domain's DNS TXT record and mark it verified
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
 }
 
 type urlShortenerClient struct {
@@ -139,6 +169,106 @@ func (c *urlShortenerClient) ListAllURLs(ctx context.Context, in *ListAllURLsReq
 	return out, nil
 }
 
+func (c *urlShortenerClient) ListMyURLs(ctx context.Context, in *ListMyURLsRequest, opts ...grpc.CallOption) (*ListMyURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyURLsResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_ListMyURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_CreateWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*InviteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteMemberResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_InviteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) TransferURL(ctx context.Context, in *TransferURLRequest, opts ...grpc.CallOption) (*TransferURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferURLResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_TransferURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_GetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterDomainResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_RegisterDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShortenerClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, UrlShortener_VerifyDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UrlShortenerServer is the server API for UrlShortener service.
 // All implementations must embed UnimplementedUrlShortenerServer
 // for forward compatibility.
@@ -149,16 +279,36 @@ type UrlShortenerServer interface {
 	GetOriginalURL(context.Context, *GetOriginalURLRequest) (*GetOriginalURLResponse, error)
 	// Increment click counter whenever a short link is used
 	IncrementClick(context.Context, *IncrementClickRequest) (*IncrementClickResponse, error)
-	// ✅ New RPC: Health check endpoint
+	// Health check endpoint
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// ✅ New RPC: Get analytics and metadata for a specific URL
+	// Get analytics and metadata for a specific URL
 	GetURLStats(context.Context, *GetURLStatsRequest) (*GetURLStatsResponse, error)
-	// ✅ New RPC: Update an existing short URL (change destination or expiry)
+	// Update an existing short URL (change destination or expiry)
 	UpdateURL(context.Context, *UpdateURLRequest) (*UpdateURLResponse, error)
-	// ✅ New RPC: Delete a short URL by ID
+	// Delete a short URL by ID
 	DeleteURL(context.Context, *DeleteURLRequest) (*DeleteURLResponse, error)
-	// ✅ New RPC: List all shortened URLs (with optional pagination)
+	// List all shortened URLs (with optional pagination, admin scope)
 	ListAllURLs(context.Context, *ListAllURLsRequest) (*ListAllURLsResponse, error)
+	// List the caller's own shortened URLs (with optional pagination)
+	ListMyURLs(context.Context, *ListMyURLsRequest) (*ListMyURLsResponse, error)
+	// Create an API key for this API (admin scope)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// Revoke an API key by ID (admin scope)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// Create a workspace; the caller becomes its admin
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+	// Add a member to a workspace or change their role (workspace admin)
+	InviteMember(context.Context, *InviteMemberRequest) (*InviteMemberResponse, error)
+	// Remove a member from a workspace (workspace admin)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// Move a short URL to another workspace, or back to personal links
+	TransferURL(context.Context, *TransferURLRequest) (*TransferURLResponse, error)
+	// Get the caller's link quotas and current usage
+	GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error)
+	// Register a custom domain for short links
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	// Check a registered domain's DNS TXT record and mark it verified
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
 	mustEmbedUnimplementedUrlShortenerServer()
 }
 
@@ -193,6 +343,36 @@ func (UnimplementedUrlShortenerServer) DeleteURL(context.Context, *DeleteURLRequ
 func (UnimplementedUrlShortenerServer) ListAllURLs(context.Context, *ListAllURLsRequest) (*ListAllURLsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllURLs not implemented")
 }
+func (UnimplementedUrlShortenerServer) ListMyURLs(context.Context, *ListMyURLsRequest) (*ListMyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyURLs not implemented")
+}
+func (UnimplementedUrlShortenerServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUrlShortenerServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedUrlShortenerServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedUrlShortenerServer) InviteMember(context.Context, *InviteMemberRequest) (*InviteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (UnimplementedUrlShortenerServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedUrlShortenerServer) TransferURL(context.Context, *TransferURLRequest) (*TransferURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferURL not implemented")
+}
+func (UnimplementedUrlShortenerServer) GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedUrlShortenerServer) RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDomain not implemented")
+}
+func (UnimplementedUrlShortenerServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
 func (UnimplementedUrlShortenerServer) mustEmbedUnimplementedUrlShortenerServer() {}
 func (UnimplementedUrlShortenerServer) testEmbeddedByValue()                      {}
 
@@ -358,6 +538,186 @@ func _UrlShortener_ListAllURLs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UrlShortener_ListMyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).ListMyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_ListMyURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).ListMyURLs(ctx, req.(*ListMyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).CreateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_CreateWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_InviteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).InviteMember(ctx, req.(*InviteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_TransferURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).TransferURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_TransferURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).TransferURL(ctx, req.(*TransferURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_GetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_RegisterDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).RegisterDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_RegisterDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).RegisterDomain(ctx, req.(*RegisterDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShortener_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShortenerServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShortener_VerifyDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShortenerServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UrlShortener_ServiceDesc is the grpc.ServiceDesc for UrlShortener service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +757,46 @@ var UrlShortener_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllURLs",
 			Handler:    _UrlShortener_ListAllURLs_Handler,
 		},
+		{
+			MethodName: "ListMyURLs",
+			Handler:    _UrlShortener_ListMyURLs_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _UrlShortener_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _UrlShortener_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _UrlShortener_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _UrlShortener_InviteMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _UrlShortener_RemoveMember_Handler,
+		},
+		{
+			MethodName: "TransferURL",
+			Handler:    _UrlShortener_TransferURL_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _UrlShortener_GetQuota_Handler,
+		},
+		{
+			MethodName: "RegisterDomain",
+			Handler:    _UrlShortener_RegisterDomain_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _UrlShortener_VerifyDomain_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",